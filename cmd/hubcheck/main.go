@@ -4,28 +4,57 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/gobwas/glob"
 	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/config"
+	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
 	orgRules "go.debugged.it/hubcheck/rules/org"
 	repoRules "go.debugged.it/hubcheck/rules/repo"
 )
 
 func main() {
+	command := ""
+	args := os.Args[1:]
+	if len(args) > 0 && (args[0] == "collect" || args[0] == "evaluate") {
+		command = args[0]
+		args = args[1:]
+	}
+
 	org := ""
+	user := ""
 	printRules := false
 	logLevel := string(hublog.Info)
 	ignoreFiles := "vendor/**;venv/**;virtualenv/**"
 	reportFilesContaining := ""
+	output := "snapshot.json"
+	configFile := ""
 
-	flag.StringVar(&org, "org", "", "Organization ID (in case you have access to more than one organization)")
-	flag.BoolVar(&printRules, "rules", false, "List all rules.")
-	flag.StringVar(&logLevel, "log-level", logLevel, "Minimum log level (debug, info, notice, warning, error).")
-	flag.StringVar(&ignoreFiles, "ignore-files", ignoreFiles, "Vendor directories to ignore from analysis.")
-	flag.StringVar(&reportFilesContaining, "report-files-containing", reportFilesContaining, "Report files containing this term.")
-	flag.Parse()
+	name := filepath.Base(os.Args[0])
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage:\n\n")
+		_, _ = fmt.Fprintf(flags.Output(), "  %s [options]                        Check the organization\n", name)
+		_, _ = fmt.Fprintf(flags.Output(), "  %s collect [options]                Collect a snapshot for offline evaluation\n", name)
+		_, _ = fmt.Fprintf(flags.Output(), "  %s evaluate [options] snapshot.json Evaluate a snapshot without network access\n\n", name)
+		_, _ = fmt.Fprintf(flags.Output(), "Options:\n\n")
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&org, "org", "", "Comma-separated list of organization IDs or glob patterns, 'all' for all organizations, or 'enterprise:slug' for all organizations in an enterprise (in case you have access to more than one organization)")
+	flags.StringVar(&user, "user", "", "Comma-separated list of user accounts to check, or '@me' for your own account")
+	flags.StringVar(&configFile, "config", configFile, "JSON file containing the policy to check against.")
+	flags.BoolVar(&printRules, "rules", false, "List all rules.")
+	flags.StringVar(&logLevel, "log-level", logLevel, "Minimum log level (debug, info, notice, warning, error).")
+	flags.StringVar(&ignoreFiles, "ignore-files", ignoreFiles, "Vendor directories to ignore from analysis.")
+	flags.StringVar(&reportFilesContaining, "report-files-containing", reportFilesContaining, "Report files containing this term.")
+	if command == "collect" {
+		flags.StringVar(&output, "output", output, "File to write the snapshot to.")
+	}
+	_ = flags.Parse(args)
 
 	logger := hublog.New(hublog.Level(logLevel))
 
@@ -40,8 +69,14 @@ func main() {
 		}
 	}
 
-	orgRuleList := orgRules.New()
-	repoRuleList := repoRules.New(ignoreFilesList, reportFilesContaining)
+	cfg, err := config.ReadFile(configFile)
+	if err != nil {
+		logger.WithLevel(hublog.Error).Loge(err)
+		os.Exit(1)
+	}
+
+	orgRuleList := orgRules.New(cfg)
+	repoRuleList := repoRules.New(cfg, ignoreFilesList, reportFilesContaining)
 	if printRules {
 		for _, rule := range orgRuleList {
 			fmt.Printf("## %s\n\n%s\n\nRead more: %s\n\n", rule.Name(), rule.Description(), rule.DocURL())
@@ -52,16 +87,39 @@ func main() {
 		return
 	}
 
-	token := os.Getenv("GITHUB_TOKEN")
-	if token == "" {
-		logger.WithLevel(hublog.Error).Logf("Please set the GITHUB_TOKEN environment variable.")
-		os.Exit(1)
-	}
-
-	hc, err := hubcheck.New(logger, token, org)
-	if err != nil {
-		logger.WithLevel(hublog.Error).Loge(err)
-		os.Exit(1)
+	var hc hubcheck.HubCheck
+	var recorder github.RecordingClient
+	switch command {
+	case "evaluate":
+		if flags.NArg() != 1 {
+			logger.WithLevel(hublog.Error).Logf("Please provide the snapshot file to evaluate.")
+			os.Exit(1)
+		}
+		hc, err = evaluateSnapshot(logger, flags.Arg(0), org, user)
+		if err != nil {
+			logger.WithLevel(hublog.Error).Loge(err)
+			os.Exit(1)
+		}
+	default:
+		token := os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			logger.WithLevel(hublog.Error).Logf("Please set the GITHUB_TOKEN environment variable.")
+			os.Exit(1)
+		}
+		ghClient, err := github.NewClient(logger, token, "", nil)
+		if err != nil {
+			logger.WithLevel(hublog.Error).Loge(err)
+			os.Exit(1)
+		}
+		if command == "collect" {
+			recorder = github.NewRecordingClient(ghClient)
+			ghClient = recorder
+		}
+		hc, err = hubcheck.NewWithClient(logger, ghClient, org, user)
+		if err != nil {
+			logger.WithLevel(hublog.Error).Loge(err)
+			os.Exit(1)
+		}
 	}
 
 	results, err := hc.Run(
@@ -73,27 +131,121 @@ func main() {
 		os.Exit(1)
 	}
 
-	print("# Report for the " + org + " GitHub organization\n\n")
+	if recorder != nil {
+		if err := saveSnapshot(recorder.Snapshot(), org, user, output); err != nil {
+			logger.WithLevel(hublog.Error).Loge(err)
+			os.Exit(1)
+		}
+		logger.WithLevel(hublog.Notice).Logf("Snapshot written to %s.", output)
+		return
+	}
+
+	var ownerLogins []string
+	ownerTypes := map[string]github.OwnerType{}
+	for _, owner := range hc.Owners() {
+		ownerLogins = append(ownerLogins, owner.GetLogin())
+		ownerTypes[owner.GetLogin()] = owner.OwnerType()
+	}
+	switch {
+	case len(ownerLogins) == 0:
+		print("# Report\n\n")
+	case len(ownerLogins) > 1:
+		print(fmt.Sprintf("# Report for %d GitHub accounts: %s\n\n", len(ownerLogins), strings.Join(ownerLogins, ", ")))
+	case ownerTypes[ownerLogins[0]] == github.OwnerTypeUser:
+		print("# Report for the " + ownerLogins[0] + " GitHub user account\n\n")
+	default:
+		print("# Report for the " + ownerLogins[0] + " GitHub organization\n\n")
+	}
+
+	var ruleIDs []string
+	for rule := range results {
+		ruleIDs = append(ruleIDs, rule)
+	}
+	sort.Strings(ruleIDs)
+
 	failed := false
-	for rule, resultList := range results {
-		for _, result := range resultList {
-			if result.Level == hublog.Warning || result.Level == hublog.Error {
-				failed = true
-			}
-			name := result.Title
-			if result.Repository != "" {
-				name = fmt.Sprintf("%s on %s", name, result.Repository)
+	failedPerOwner := map[string]int{}
+	for _, ownerLogin := range ownerLogins {
+		for _, rule := range ruleIDs {
+			for _, result := range results[rule] {
+				if result.Owner != ownerLogin {
+					continue
+				}
+				if result.Level == hublog.Warning || result.Level == hublog.Error {
+					failed = true
+					failedPerOwner[ownerLogin]++
+				}
+				printResult(rule, result, ownerTypes[ownerLogin], hublog.Level(logLevel))
 			}
-
-			printResult(org, rule, result, hublog.Level(logLevel))
 		}
 	}
+	if len(ownerLogins) > 1 {
+		print("# Summary\n\n")
+		print("| Account | Warnings and errors |\n")
+		print("|---------|---------------------|\n")
+		for _, ownerLogin := range ownerLogins {
+			print(fmt.Sprintf("| %s | %d |\n", ownerLogin, failedPerOwner[ownerLogin]))
+		}
+		print("\n")
+	}
 	if failed {
 		os.Exit(1)
 	}
 }
 
-func printResult(org string, rule string, result hubcheck.RuleResult, level hublog.Level) {
+// evaluateSnapshot creates a HubCheck instance that replays the snapshot in the file. If neither the org nor the user
+// selector is given, the selectors the snapshot was collected with are used.
+func evaluateSnapshot(logger hublog.Logger, file string, org string, user string) (hubcheck.HubCheck, error) {
+	snapshot, err := loadSnapshot(file)
+	if err != nil {
+		return nil, err
+	}
+	if org == "" && user == "" {
+		org = snapshot.Org
+		user = snapshot.User
+	}
+	ghClient, err := github.NewSnapshotClient(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return hubcheck.NewWithClient(logger, ghClient, org, user)
+}
+
+func loadSnapshot(file string) (*github.Snapshot, error) {
+	fh, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot file %s (%w)", file, err)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	snapshot, err := github.ReadSnapshot(fh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file %s (%w)", file, err)
+	}
+	return snapshot, nil
+}
+
+func saveSnapshot(snapshot *github.Snapshot, org string, user string, file string) error {
+	snapshot.Org = org
+	snapshot.User = user
+	// The snapshot may contain file contents from private repositories.
+	fh, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file %s (%w)", file, err)
+	}
+	if err := snapshot.Write(fh); err != nil {
+		_ = fh.Close()
+		return fmt.Errorf("failed to write snapshot file %s (%w)", file, err)
+	}
+	if err := fh.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot file %s (%w)", file, err)
+	}
+	return nil
+}
+
+func printResult(rule string, result hubcheck.RuleResult, ownerType github.OwnerType, level hublog.Level) {
+	owner := result.Owner
 	prefix := ""
 	suffix := "\033[0m\n\n"
 	switch result.Level {
@@ -124,9 +276,11 @@ func printResult(org string, rule string, result hubcheck.RuleResult, level hubl
 	print(prefix + result.Title + " (`" + rule + "`)" + suffix)
 	print(result.Description + "\n\n")
 	if result.Repository != "" {
-		print("- \033[1m**Repository:**\033[0m [" + org + "/" + result.Repository + "](https://github.com/" + org + "/" + result.Repository + ")\n")
+		print("- \033[1m**Repository:**\033[0m [" + owner + "/" + result.Repository + "](https://github.com/" + owner + "/" + result.Repository + ")\n")
+	} else if ownerType == github.OwnerTypeUser {
+		print("- \033[1m**User:**\033[0m [" + owner + "](https://github.com/" + owner + ")\n")
 	} else {
-		print("- \033[1m**Organization:**\033[0m [" + org + "](https://github.com/" + org + ")\n")
+		print("- \033[1m**Organization:**\033[0m [" + owner + "](https://github.com/" + owner + ")\n")
 	}
 	if result.FixURL != "" {
 		print("- \033[1m**Quick fix:**\033[0m " + result.FixURL + "\n")
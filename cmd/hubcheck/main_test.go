@@ -0,0 +1,59 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/invitations"
+)
+
+func TestCollectEvaluate(t *testing.T) {
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{
+			Organization: github.Organization{Login: "acme"},
+			Repositories: []*githubtest.Repository{{Repository: github.Repository{Name: "app"}}},
+		},
+		{Organization: github.Organization{Login: "other"}},
+	}})
+	defer srv.Close()
+	logger := hublog.New(hublog.Error)
+	c, err := srv.NewClient(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orgRules := []hubcheck.OrgRule{invitations.New(invitations.DefaultPolicy())}
+
+	recorder := github.NewRecordingClient(c)
+	hc, err := hubcheck.NewWithClient(logger, recorder, "acme", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	collected, err := hc.Run(orgRules, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := saveSnapshot(recorder.Snapshot(), "acme", "", file); err != nil {
+		t.Fatal(err)
+	}
+
+	hc, err = evaluateSnapshot(logger, file, "", "")
+	if err != nil {
+		t.Fatalf("the snapshot could not be evaluated without selectors (%v)", err)
+	}
+	if owners := hc.Owners(); len(owners) != 1 || owners[0].GetLogin() != "acme" {
+		t.Fatalf("unexpected owners: %v", owners)
+	}
+	evaluated, err := hc.Run(orgRules, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(collected, evaluated) {
+		t.Fatalf("the evaluated results differ from the collected ones:\n%v\n%v", collected, evaluated)
+	}
+}
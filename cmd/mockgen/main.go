@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// This program generates github/githubmock/client.go from the github.Client interface.
+func main() {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, "github", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	pkg, ok := packages["github"]
+	if !ok {
+		log.Fatalln("github package not found")
+	}
+
+	var iface *ast.InterfaceType
+	var ifaceFile *ast.File
+	for _, file := range pkg.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok || spec.Name.Name != "Client" {
+				return true
+			}
+			if t, ok := spec.Type.(*ast.InterfaceType); ok {
+				iface = t
+				ifaceFile = file
+			}
+			return false
+		})
+	}
+	if iface == nil {
+		log.Fatalln("github.Client interface not found")
+	}
+
+	g := &generator{
+		imports: map[string]string{},
+		known:   map[string]string{},
+	}
+	for _, imp := range ifaceFile.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		g.known[name] = importPath
+	}
+
+	source, err := g.generate(iface)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join("github", "githubmock", "client.go"), source, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+type method struct {
+	name    string
+	params  []string
+	args    []string
+	results []string
+	named   []string
+	funcSig string
+}
+
+type generator struct {
+	// imports contains the import paths used by the generated code, indexed by package name.
+	imports map[string]string
+	// known contains the import paths of the file declaring the interface, indexed by package name.
+	known map[string]string
+}
+
+func (g *generator) generate(iface *ast.InterfaceType) ([]byte, error) {
+	g.imports["fmt"] = "fmt"
+	g.imports["sync"] = "sync"
+	g.imports["github"] = "go.debugged.it/hubcheck/github"
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+		m, err := g.method(field.Names[0].Name, funcType)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, m)
+	}
+
+	out := &bytes.Buffer{}
+	out.WriteString("// Code generated by cmd/mockgen/main.go. DO NOT EDIT.\n\n")
+	out.WriteString("// Package githubmock contains a mock implementation of github.Client for unit testing rules.\n")
+	out.WriteString("package githubmock\n\n")
+	out.WriteString("import (\n")
+	var importNames []string
+	for name := range g.imports {
+		importNames = append(importNames, name)
+	}
+	sort.Slice(importNames, func(i, j int) bool {
+		return g.imports[importNames[i]] < g.imports[importNames[j]]
+	})
+	// Standard library imports go first, separated from the rest by an empty line.
+	for _, std := range []bool{true, false} {
+		if !std {
+			out.WriteString("\n")
+		}
+		for _, name := range importNames {
+			importPath := g.imports[name]
+			if strings.Contains(strings.Split(importPath, "/")[0], ".") == std {
+				continue
+			}
+			if filepath.Base(importPath) == name {
+				fmt.Fprintf(out, "\t%q\n", importPath)
+			} else {
+				fmt.Fprintf(out, "\t%s %q\n", name, importPath)
+			}
+		}
+	}
+	out.WriteString(")\n\n")
+
+	out.WriteString("// Client is a mock implementation of github.Client. Set the function field for each method the code under test\n")
+	out.WriteString("// calls. Methods without a function return an error.\n")
+	out.WriteString("type Client struct {\n")
+	for _, m := range methods {
+		fmt.Fprintf(out, "\t%sFunc %s\n", m.name, m.funcSig)
+	}
+	out.WriteString("\n\tlock  sync.Mutex\n\tcalls []Call\n}\n\n")
+
+	out.WriteString(`// Call is a method call recorded by the mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Calls returns the method calls made on the mock so far.
+func (c *Client) Calls() []Call {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]Call(nil), c.calls...)
+}
+
+func (c *Client) record(method string, args ...interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("githubmock: %s is not mocked", method)
+}
+
+`)
+
+	for _, m := range methods {
+		var results []string
+		for i, r := range m.results {
+			results = append(results, m.named[i]+" "+r)
+		}
+		fmt.Fprintf(
+			out,
+			"func (c *Client) %s(%s) (%s) {\n",
+			m.name,
+			strings.Join(m.params, ", "),
+			strings.Join(results, ", "),
+		)
+		recordArgs := append([]string{strconv.Quote(m.name)}, m.args...)
+		fmt.Fprintf(out, "\tc.record(%s)\n", strings.Join(recordArgs, ", "))
+		fmt.Fprintf(out, "\tif c.%sFunc == nil {\n", m.name)
+		zero := append([]string(nil), m.named...)
+		if len(m.results) > 0 && m.results[len(m.results)-1] == "error" {
+			zero[len(zero)-1] = fmt.Sprintf("notMocked(%q)", m.name)
+			fmt.Fprintf(out, "\t\treturn %s\n", strings.Join(zero, ", "))
+		} else {
+			fmt.Fprintf(out, "\t\tpanic(notMocked(%q))\n", m.name)
+		}
+		out.WriteString("\t}\n")
+		fmt.Fprintf(out, "\treturn c.%sFunc(%s)\n}\n\n", m.name, strings.Join(m.args, ", "))
+	}
+
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) method(name string, funcType *ast.FuncType) (method, error) {
+	m := method{name: name}
+	var paramTypes []string
+	i := 0
+	for _, param := range funcType.Params.List {
+		typeString, err := g.typeString(param.Type)
+		if err != nil {
+			return m, fmt.Errorf("%s: %w", name, err)
+		}
+		names := param.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, paramName := range names {
+			argName := paramName.Name
+			if _, variadic := param.Type.(*ast.Ellipsis); variadic {
+				argName += "..."
+			}
+			m.params = append(m.params, paramName.Name+" "+typeString)
+			m.args = append(m.args, argName)
+			paramTypes = append(paramTypes, typeString)
+			i++
+		}
+	}
+	if funcType.Results != nil {
+		for _, result := range funcType.Results.List {
+			typeString, err := g.typeString(result.Type)
+			if err != nil {
+				return m, fmt.Errorf("%s: %w", name, err)
+			}
+			count := len(result.Names)
+			if count == 0 {
+				count = 1
+			}
+			for j := 0; j < count; j++ {
+				m.named = append(m.named, fmt.Sprintf("r%d", len(m.results)))
+				m.results = append(m.results, typeString)
+			}
+		}
+	}
+	if len(m.results) > 0 && m.results[len(m.results)-1] == "error" {
+		m.named[len(m.named)-1] = "err"
+	}
+	m.funcSig = fmt.Sprintf("func(%s) (%s)", strings.Join(paramTypes, ", "), strings.Join(m.results, ", "))
+	return m, nil
+}
+
+// typeString renders a type expression from the github package so that it can be used from the githubmock package.
+func (g *generator) typeString(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return "github." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.StarExpr:
+		inner, err := g.typeString(t.X)
+		return "*" + inner, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("fixed size arrays are not supported")
+		}
+		inner, err := g.typeString(t.Elt)
+		return "[]" + inner, err
+	case *ast.Ellipsis:
+		inner, err := g.typeString(t.Elt)
+		return "..." + inner, err
+	case *ast.MapType:
+		key, err := g.typeString(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typeString(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector expression")
+		}
+		importPath, ok := g.known[pkg.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", pkg.Name)
+		}
+		g.imports[pkg.Name] = importPath
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("non-empty inline interfaces are not supported")
+		}
+		return "interface{}", nil
+	default:
+		return "", fmt.Errorf("unsupported type expression %T", expr)
+	}
+}
@@ -6,16 +6,17 @@ import (
 	"log"
 	"regexp"
 
+	"go.debugged.it/hubcheck/config"
 	orgRules "go.debugged.it/hubcheck/rules/org"
 	repoRules "go.debugged.it/hubcheck/rules/repo"
 )
 
 func main() {
 	output := "<!-- region Rules -->\n\n"
-	for _, rule := range orgRules.New() {
+	for _, rule := range orgRules.New(config.Default()) {
 		output += fmt.Sprintf("### %s\n\n%s\n\nRead more: %s\n\n", rule.Name(), rule.Description(), rule.DocURL())
 	}
-	for _, rule := range repoRules.New(nil, "") {
+	for _, rule := range repoRules.New(config.Default(), nil, "") {
 		output += fmt.Sprintf("### %s\n\n%s\n\n", rule.Name(), rule.Description())
 		if rule.DocURL() != "" {
 			output += fmt.Sprintf("Read more: %s\n\n", rule.DocURL())
@@ -0,0 +1,92 @@
+// Package config contains the policy configuration rules are evaluated against. The configuration is read from a JSON
+// file passed using the -config parameter. Settings that are not present in the file keep their default values.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.debugged.it/hubcheck/rules/org/appinstallations"
+	"go.debugged.it/hubcheck/rules/org/defaultrepopermission"
+	"go.debugged.it/hubcheck/rules/org/deploykeys"
+	"go.debugged.it/hubcheck/rules/org/domains"
+	"go.debugged.it/hubcheck/rules/org/invitations"
+	"go.debugged.it/hubcheck/rules/org/memberprivileges"
+	"go.debugged.it/hubcheck/rules/org/orgadmins"
+	"go.debugged.it/hubcheck/rules/org/secrets"
+	"go.debugged.it/hubcheck/rules/org/teams"
+	"go.debugged.it/hubcheck/rules/selectedactions"
+)
+
+// Config is the policy configuration of all configurable rules.
+type Config struct {
+	// DefaultRepoPermission configures the default-repository-permission rule.
+	DefaultRepoPermission defaultrepopermission.Policy `json:"default_repository_permission"`
+	// MemberPrivileges configures the member-privileges rule.
+	MemberPrivileges memberprivileges.Policy `json:"member_privileges"`
+	// OrgAdmins configures the organization-admins rule.
+	OrgAdmins orgadmins.Policy `json:"org_admins"`
+	// AppInstallations configures the github-app-installations rule.
+	AppInstallations appinstallations.Policy `json:"app_installations"`
+	// Secrets configures the organization-secrets rule.
+	Secrets secrets.Policy `json:"secrets"`
+	// Teams configures the team-permissions rule.
+	Teams teams.Policy `json:"teams"`
+	// Invitations configures the organization-invitations rule.
+	Invitations invitations.Policy `json:"invitations"`
+	// DeployKeys configures the deploy-keys rule.
+	DeployKeys deploykeys.Policy `json:"deploy_keys"`
+	// Domains configures the web-commit-signoff-and-domains rule. It can be set for each organization.
+	Domains domains.Policy `json:"domains"`
+	// SelectedActions configures the allowlist checks of the GitHub Actions permissions rules on organizations and
+	// repositories.
+	SelectedActions selectedactions.Policy `json:"selected_actions"`
+}
+
+// Default returns the configuration used when no configuration file is provided.
+func Default() Config {
+	return Config{
+		DefaultRepoPermission: defaultrepopermission.DefaultPolicy(),
+		OrgAdmins:             orgadmins.DefaultPolicy(),
+		Secrets:               secrets.DefaultPolicy(),
+		Teams:                 teams.DefaultPolicy(),
+		Invitations:           invitations.DefaultPolicy(),
+		DeployKeys:            deploykeys.DefaultPolicy(),
+		Domains:               domains.DefaultPolicy(),
+	}
+}
+
+// Read decodes a configuration on top of the default configuration. Unknown keys are rejected to catch typos.
+func Read(reader io.Reader) (Config, error) {
+	cfg := Default()
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return cfg, fmt.Errorf("failed to decode configuration (%w)", err)
+	}
+	if err := cfg.DefaultRepoPermission.Validate(); err != nil {
+		return cfg, fmt.Errorf("invalid default_repository_permission configuration (%w)", err)
+	}
+	return cfg, nil
+}
+
+// ReadFile reads the configuration from a file. If the file name is empty, the default configuration is returned.
+func ReadFile(file string) (Config, error) {
+	if file == "" {
+		return Default(), nil
+	}
+	fh, err := os.Open(file)
+	if err != nil {
+		return Default(), fmt.Errorf("failed to open configuration file %s (%w)", file, err)
+	}
+	defer func() {
+		_ = fh.Close()
+	}()
+	cfg, err := Read(fh)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read configuration file %s (%w)", file, err)
+	}
+	return cfg, nil
+}
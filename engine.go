@@ -2,7 +2,9 @@ package hubcheck
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/gobwas/glob"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
 )
@@ -19,13 +21,16 @@ type OrgRule interface {
 	Run(org *github.Organization) ([]RuleResult, error)
 }
 
+// RepoRule is a rule checking a single repository. The owner is either a *github.Organization or a *github.User.
 type RepoRule interface {
 	Rule
-	Run(org *github.Organization, repo *github.Repository) ([]RuleResult, error)
+	Run(owner github.Owner, repo *github.Repository) ([]RuleResult, error)
 }
 
 type RuleResult struct {
-	Level       hublog.Level
+	Level hublog.Level
+	// Owner is the login of the organization or user account the result belongs to.
+	Owner       string
 	Repository  string
 	Title       string
 	Description string
@@ -34,91 +39,276 @@ type RuleResult struct {
 }
 
 type HubCheck interface {
+	// Owners returns the organizations and user accounts selected for checking.
+	Owners() []github.Owner
 	Run(orgRules []OrgRule, repoRules []RepoRule) (map[string][]RuleResult, error)
 }
 
-func New(logger hublog.Logger, token string, orgID string) (HubCheck, error) {
+// New creates a HubCheck instance for the organizations selected by orgSelector and the user accounts selected by
+// userSelector. See NewWithClient for the selector syntax.
+func New(logger hublog.Logger, token string, orgSelector string, userSelector string) (HubCheck, error) {
 	if token == "" {
 		return nil, fmt.Errorf("no access token provided")
 	}
-	ghClient, err := github.NewClient(logger, token)
+	ghClient, err := github.NewClient(logger, token, "", nil)
 	if err != nil {
 		return nil, err
 	}
-	var org *github.Organization
-	if orgID != "" {
-		org, err = ghClient.GetOrg(orgID)
+	return NewWithClient(logger, ghClient, orgSelector, userSelector)
+}
+
+// NewWithClient creates a HubCheck instance using an existing GitHub client. This is useful for running the rules
+// against a different API endpoint, such as a githubtest server.
+//
+// The orgSelector is a comma-separated list of organization logins, glob patterns matched against the organizations
+// your token has access to, "all" for all of them, or "enterprise:slug" for all organizations of an enterprise. The
+// userSelector is a comma-separated list of user logins, or "@me" for the owner of the token. If both are empty, the
+// token must have access to exactly one organization.
+func NewWithClient(
+	logger hublog.Logger,
+	ghClient github.Client,
+	orgSelector string,
+	userSelector string,
+) (HubCheck, error) {
+	var owners []github.Owner
+	if strings.TrimSpace(orgSelector) != "" || strings.TrimSpace(userSelector) == "" {
+		orgs, err := selectOrganizations(ghClient, orgSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, org := range orgs {
+			owners = append(owners, org)
+		}
+	}
+	users, err := selectUsers(ghClient, userSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		owners = append(owners, user)
+	}
+	if len(owners) == 0 {
+		return nil, fmt.Errorf("no account selected, please check the -org and -user parameters")
+	}
+	return &hubCheck{
+		logger: logger,
+		client: ghClient,
+		owners: owners,
+	}, nil
+}
+
+func selectUsers(ghClient github.Client, userSelector string) ([]*github.User, error) {
+	var result []*github.User
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(userSelector, ",") {
+		entry = strings.TrimSpace(entry)
+		var user *github.User
+		var err error
+		switch entry {
+		case "":
+			continue
+		case "@me":
+			user, err = ghClient.GetAuthenticatedUser()
+		default:
+			user, err = ghClient.GetUser(entry)
+		}
 		if err != nil {
 			return nil, err
 		}
-	} else {
+		if !seen[user.Login] {
+			seen[user.Login] = true
+			result = append(result, user)
+		}
+	}
+	return result, nil
+}
+
+func selectOrganizations(ghClient github.Client, orgSelector string) ([]*github.Organization, error) {
+	if strings.TrimSpace(orgSelector) == "" {
 		orgs, err := ghClient.ListOrganizations()
 		if err != nil {
 			return nil, err
 		}
 		if len(orgs) == 0 {
 			return nil, fmt.Errorf("no organization found using your access token")
-		} else if len(orgs) == 1 {
-			org = orgs[0]
-		} else {
-			return nil, fmt.Errorf("more than one organization found in your account, please provide the organization ID using the -org parameter")
+		} else if len(orgs) > 1 {
+			return nil, fmt.Errorf("more than one organization found in your account, please provide the organization IDs using the -org parameter, or use -org all")
 		}
+		return orgs, nil
+	}
 
+	var result []*github.Organization
+	seen := map[string]bool{}
+	add := func(org *github.Organization) {
+		if !seen[org.Login] {
+			seen[org.Login] = true
+			result = append(result, org)
+		}
 	}
-	return &hubCheck{
-		logger: logger,
-		client: ghClient,
-		org:    org,
-	}, nil
+	var accessibleOrgs []*github.Organization
+	for _, entry := range strings.Split(orgSelector, ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+		case strings.HasPrefix(entry, "enterprise:"):
+			slug := strings.TrimPrefix(entry, "enterprise:")
+			logins, err := ghClient.ListEnterpriseOrganizations(slug)
+			if err != nil {
+				return nil, err
+			}
+			if len(logins) == 0 {
+				return nil, fmt.Errorf("no organizations found in the %s enterprise", slug)
+			}
+			for _, login := range logins {
+				if seen[login] {
+					continue
+				}
+				org, err := ghClient.GetOrg(login)
+				if err != nil {
+					return nil, err
+				}
+				add(org)
+			}
+		case entry == "all" || strings.ContainsAny(entry, "*?[{"):
+			pattern, err := glob.Compile(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid organization pattern %s (%w)", entry, err)
+			}
+			if accessibleOrgs == nil {
+				accessibleOrgs, err = ghClient.ListOrganizations()
+				if err != nil {
+					return nil, err
+				}
+			}
+			matched := false
+			for _, org := range accessibleOrgs {
+				if entry == "all" || pattern.Match(org.Login) {
+					matched = true
+					add(org)
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("no organization found matching %s using your access token", entry)
+			}
+		default:
+			if seen[entry] {
+				continue
+			}
+			org, err := ghClient.GetOrg(entry)
+			if err != nil {
+				return nil, err
+			}
+			add(org)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no organization selected")
+	}
+	return result, nil
 }
 
 type hubCheck struct {
 	client github.Client
-	org    *github.Organization
+	owners []github.Owner
 	logger hublog.Logger
 }
 
+func (h hubCheck) Owners() []github.Owner {
+	return h.owners
+}
+
 func (h hubCheck) Run(orgRules []OrgRule, repoRules []RepoRule) (map[string][]RuleResult, error) {
 	results := map[string][]RuleResult{}
+	for _, owner := range h.owners {
+		h.runOwner(owner, orgRules, repoRules, results)
+	}
+	return results, nil
+}
+
+func (h hubCheck) runOwner(
+	owner github.Owner,
+	orgRules []OrgRule,
+	repoRules []RepoRule,
+	results map[string][]RuleResult,
+) {
+	login := owner.GetLogin()
 	for _, rule := range orgRules {
-		h.logger.WithLevel(hublog.Debug).Logf("Processing rule %s...", rule.ID())
-		result, err := rule.Run(h.org)
+		org, ok := owner.(*github.Organization)
+		if !ok {
+			results[rule.ID()] = append(
+				results[rule.ID()],
+				RuleResult{
+					Level:       hublog.Info,
+					Owner:       login,
+					Title:       "Not applicable to user accounts",
+					Description: fmt.Sprintf("%s is a user account, this rule only applies to organizations.", login),
+					DocURL:      rule.DocURL(),
+				},
+			)
+			continue
+		}
+		h.logger.WithLevel(hublog.Debug).Logf("Processing rule %s on organization %s...", rule.ID(), login)
+		result, err := rule.Run(org)
 		if err != nil {
-			results[rule.ID()] = []RuleResult{
-				{
+			results[rule.ID()] = append(
+				results[rule.ID()],
+				RuleResult{
 					Level:       hublog.Warning,
+					Owner:       login,
 					Title:       "Rule execution failed",
 					Description: err.Error(),
 				},
-			}
+			)
 		} else {
-			results[rule.ID()] = result
+			results[rule.ID()] = append(results[rule.ID()], withOwner(result, login)...)
 		}
 	}
-	repos, err := h.org.ListRepositories()
+	repos, err := owner.ListRepositories()
 	if err != nil {
-		return results, fmt.Errorf("failed to list organization repositories (%w)", err)
+		// Report the failure on every repository rule and carry on with the next owner.
+		for _, rule := range repoRules {
+			results[rule.ID()] = append(
+				results[rule.ID()],
+				RuleResult{
+					Level:       hublog.Warning,
+					Owner:       login,
+					Title:       "Rule execution failed",
+					Description: fmt.Sprintf("Failed to list %s repositories (%v)", login, err),
+				},
+			)
+		}
+		return
 	}
 	for _, rule := range repoRules {
 		for _, repo := range repos {
 			if _, ok := results[rule.ID()]; !ok {
 				results[rule.ID()] = nil
 			}
-			h.logger.WithLevel(hublog.Debug).Logf("Processing rule %s on repository %s...", rule.ID(), repo.Name)
-			result, err := rule.Run(h.org, repo)
+			h.logger.WithLevel(hublog.Debug).Logf("Processing rule %s on repository %s/%s...", rule.ID(), login, repo.Name)
+			result, err := rule.Run(owner, repo)
 			if err != nil {
 				results[rule.ID()] = append(
 					results[rule.ID()],
 					RuleResult{
 						Level:       hublog.Warning,
+						Owner:       login,
 						Title:       fmt.Sprintf("Rule execution failed on repository %s", repo.Name),
 						Description: err.Error(),
 					},
 				)
 			} else {
-				results[rule.ID()] = append(results[rule.ID()], result...)
+				results[rule.ID()] = append(results[rule.ID()], withOwner(result, login)...)
 			}
 		}
 	}
-	return results, nil
+}
+
+// withOwner fills in the owner of results that don't specify one.
+func withOwner(results []RuleResult, login string) []RuleResult {
+	for i := range results {
+		if results[i].Owner == "" {
+			results[i].Owner = login
+		}
+	}
+	return results
 }
@@ -0,0 +1,30 @@
+package hubcheck_test
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func TestNoAccountSelected(t *testing.T) {
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{Organization: github.Organization{Login: "acme"}},
+	}})
+	defer srv.Close()
+	logger := hublog.New(hublog.Error)
+	c, err := srv.NewClient(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, selectors := range [][2]string{{",", ""}, {"", ","}, {"", " , "}} {
+		if _, err := hubcheck.NewWithClient(logger, c, selectors[0], selectors[1]); err == nil {
+			t.Fatalf("no error for -org %q -user %q", selectors[0], selectors[1])
+		}
+	}
+	if _, err := hubcheck.NewWithClient(logger, c, "", ""); err != nil {
+		t.Fatalf("the only organization was not selected (%v)", err)
+	}
+}
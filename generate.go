@@ -1,3 +1,4 @@
 package hubcheck
 
 //go:generate go run cmd/readme/main.go
+//go:generate go run cmd/mockgen/main.go
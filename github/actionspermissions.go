@@ -6,3 +6,45 @@ type ActionsPermissions struct {
 	EnabledRepositories string `json:"enabled_repositories"`
 	AllowedActions      string `json:"allowed_actions"`
 }
+
+// SelectedActions is the allowlist of actions used when AllowedActions is "selected".
+type SelectedActions struct {
+	GithubOwnedAllowed bool `json:"github_owned_allowed"`
+	// VerifiedAllowed indicates if actions from verified creators on the GitHub Marketplace are allowed.
+	VerifiedAllowed bool `json:"verified_allowed"`
+	// PatternsAllowed contains the allowed action patterns, for example "acme/*" or "acme/action@v1".
+	PatternsAllowed []string `json:"patterns_allowed"`
+}
+
+// WorkflowPermissions are the default permissions of the GITHUB_TOKEN in workflows.
+type WorkflowPermissions struct {
+	// DefaultWorkflowPermissions is either "read" or "write".
+	DefaultWorkflowPermissions string `json:"default_workflow_permissions"`
+	// CanApprovePullRequestReviews indicates if GitHub Actions can approve pull requests.
+	CanApprovePullRequestReviews bool `json:"can_approve_pull_request_reviews"`
+}
+
+// LooserThan returns true if these permissions grant more than the other permissions.
+func (w WorkflowPermissions) LooserThan(other WorkflowPermissions) bool {
+	if w.DefaultWorkflowPermissions == "write" && other.DefaultWorkflowPermissions != "write" {
+		return true
+	}
+	return w.CanApprovePullRequestReviews && !other.CanApprovePullRequestReviews
+}
+
+const (
+	// ApprovalPolicyFirstTimeContributorsNewToGitHub requires approval only for first-time contributors who recently
+	// created their GitHub account.
+	ApprovalPolicyFirstTimeContributorsNewToGitHub = "first_time_contributors_new_to_github"
+	// ApprovalPolicyFirstTimeContributors requires approval for contributors who have not contributed before.
+	ApprovalPolicyFirstTimeContributors = "first_time_contributors"
+	// ApprovalPolicyAllExternalContributors requires approval for all contributors who are not members or
+	// collaborators.
+	ApprovalPolicyAllExternalContributors = "all_external_contributors"
+)
+
+// ForkPRContributorApproval describes which contributors need approval before workflows run on their pull requests
+// from forks.
+type ForkPRContributorApproval struct {
+	ApprovalPolicy string `json:"approval_policy"`
+}
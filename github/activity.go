@@ -0,0 +1,26 @@
+package github
+
+import "time"
+
+// AuditLogEntry is an entry of the organization audit log. The audit log API is only available for organizations on
+// GitHub Enterprise.
+type AuditLogEntry struct {
+	// Timestamp is the time of the event in milliseconds since the epoch.
+	Timestamp int64  `json:"@timestamp"`
+	Action    string `json:"action"`
+	Actor     string `json:"actor"`
+}
+
+// Time returns the time of the event.
+func (e AuditLogEntry) Time() time.Time {
+	return time.UnixMilli(e.Timestamp).UTC()
+}
+
+// Event is a public event of a user, for example a push to a public repository.
+type Event struct {
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Repo      struct {
+		Name string `json:"name"`
+	} `json:"repo"`
+}
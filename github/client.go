@@ -20,22 +20,121 @@ import (
 type Client interface {
 	ListOrganizations() ([]*Organization, error)
 	GetOrg(login string) (*Organization, error)
+	ListEnterpriseOrganizations(slug string) ([]string, error)
+	GetAuthenticatedUser() (*User, error)
+	GetUser(login string) (*User, error)
+	ListUserRepositories(login string) ([]*Repository, error)
+	// GetLastUserEvent returns the latest public event of a user, or nil if the user has no recent public events.
+	GetLastUserEvent(login string) (*Event, error)
 	GetGitHubActionsOrgPermissions(login string) (*ActionsPermissions, error)
 	ListOrgAdmins(login string) ([]*OrgMember, error)
+	// GetLastAuditLogEntry returns the latest audit log entry of the organization caused by the actor, or nil if there
+	// is none.
+	GetLastAuditLogEntry(login string, actor string) (*AuditLogEntry, error)
+	// GetOrgDomainSettings returns the verified and approved domains of an organization. Only organization admins can
+	// read them.
+	GetOrgDomainSettings(login string) (*OrgDomainSettings, error)
+	ListOrgHooks(login string) ([]*Hook, error)
+	ListOrgInstallations(login string) ([]*Installation, error)
+	ListOrgCustomRepoRoles(login string) ([]*CustomRepoRole, error)
+	ListOrgBlockedUsers(login string) ([]*OrgMember, error)
+	// GetOrgInteractionLimits returns the interaction limits of an organization. The limit is empty if interactions
+	// are not limited.
+	GetOrgInteractionLimits(login string) (*InteractionLimit, error)
+	ListOrgInvitations(login string) ([]*Invitation, error)
+	ListOrgFailedInvitations(login string) ([]*Invitation, error)
+	ListOrgRunnerGroups(login string) ([]*RunnerGroup, error)
+	// ListOrgRulesets lists the rulesets of an organization. The conditions and rules are only returned by
+	// GetOrgRuleset.
+	ListOrgRulesets(login string) ([]*Ruleset, error)
+	GetOrgRuleset(login string, rulesetID int64) (*Ruleset, error)
+	// ListOrgSecrets lists the metadata of the organization secrets of a feature.
+	ListOrgSecrets(login string, secretType SecretType) ([]*Secret, error)
+	// ListOrgSecretRepositories lists the repositories selected for an organization secret with the selected
+	// visibility.
+	ListOrgSecretRepositories(login string, secretType SecretType, name string) ([]*Repository, error)
+	// ListOrgVariables lists the metadata of the organization GitHub Actions variables.
+	ListOrgVariables(login string) ([]*Variable, error)
+	// ListOrgVariableRepositories lists the repositories selected for an organization variable with the selected
+	// visibility.
+	ListOrgVariableRepositories(login string, name string) ([]*Repository, error)
+	ListOrgRunnerGroupRunners(login string, groupID int64) ([]*Runner, error)
+	// ListRecentOrgHookDeliveries returns the latest deliveries of an organization webhook, latest first.
+	ListRecentOrgHookDeliveries(login string, hookID int64) ([]*HookDelivery, error)
+	// ListOrgMembers lists the members of an organization. The filter is either MemberFilterAll or
+	// MemberFilter2FADisabled.
+	ListOrgMembers(login string, filter string) ([]*OrgMember, error)
+	// ListOrgOutsideCollaborators lists the outside collaborators of an organization. The filter is either
+	// MemberFilterAll or MemberFilter2FADisabled.
+	ListOrgOutsideCollaborators(login string, filter string) ([]*OrgMember, error)
 	ListOrgRepositories(login string) ([]*Repository, error)
+	ListOrgTeams(login string) ([]*Team, error)
+	// ListTeamMembers lists the members of a team with the specified role, for example TeamRoleMaintainer.
+	ListTeamMembers(login string, teamSlug string, role string) ([]*OrgMember, error)
+	ListTeamRepositories(login string, teamSlug string) ([]*TeamRepository, error)
 	GetGitHubActionsRepoPermissions(login string, repoName string) (*ActionsPermissions, error)
+	GetGitHubActionsOrgSelectedActions(login string) (*SelectedActions, error)
+	GetGitHubActionsRepoSelectedActions(login string, repoName string) (*SelectedActions, error)
+	GetGitHubActionsOrgForkPRContributorApproval(login string) (*ForkPRContributorApproval, error)
+	GetGitHubActionsRepoForkPRContributorApproval(login string, repoName string) (*ForkPRContributorApproval, error)
+	// GetGitHubActionsOrgWorkflowPermissions returns the default GITHUB_TOKEN permissions of an organization. The
+	// result is cached, so it can be called for every repository.
+	GetGitHubActionsOrgWorkflowPermissions(login string) (*WorkflowPermissions, error)
+	GetGitHubActionsRepoWorkflowPermissions(login string, repoName string) (*WorkflowPermissions, error)
+	// ListRepoCollaborators lists the collaborators of a repository with the specified affiliation, for example
+	// AffiliationOutside.
+	ListRepoCollaborators(login string, repoName string, affiliation string) ([]*Collaborator, error)
+	ListRepoHooks(login string, repoName string) ([]*Hook, error)
+	ListRepoDeployKeys(login string, repoName string) ([]*DeployKey, error)
+	// ListRecentRepoHookDeliveries returns the latest deliveries of a repository webhook, latest first.
+	ListRecentRepoHookDeliveries(login string, repoName string, hookID int64) ([]*HookDelivery, error)
 	RepoVulnerabilityAlertsEnabled(login string, repoName string) (bool, error)
 	ListContents(login string, repoName string) ([]RepoDirEntry, error)
 	GetContents(login string, repoName string, path string) ([]byte, error)
+	GetRepoMetadata(login string, repoName string) (*RepoMetadata, error)
 }
 
-func NewClient(logger hublog.Logger, accessToken string) (Client, error) {
+// DefaultBaseURL is the base URL of the public GitHub REST API.
+const DefaultBaseURL = "https://api.github.com/"
+
+// NewClient creates a GitHub API client. The baseURL parameter can be used to point the client to a different API
+// endpoint, such as GitHub Enterprise Server or a githubtest server. If it is empty, DefaultBaseURL is used. If httpClient
+// is nil, a client with the system certificate pool is created.
+func NewClient(logger hublog.Logger, accessToken string, baseURL string, httpClient *http.Client) (Client, error) {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	if httpClient == nil {
+		var err error
+		httpClient, err = newHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &client{
+		logger:            logger,
+		accessToken:       accessToken,
+		baseURL:           baseURL,
+		graphQLURL:        graphQLURL(baseURL),
+		cli:               httpClient,
+		repoContentCache:  map[string][]RepoDirEntry{},
+		repoMetadataCache: map[string]map[string]*RepoMetadata{},
+
+		orgWorkflowPermissionsCache: map[string]*WorkflowPermissions{},
+	}, nil
+}
+
+func newHTTPClient() (*http.Client, error) {
 	certPool, err := x509.SystemCertPool()
 	if err != nil {
 		return nil, fmt.Errorf("failed to obtain the system certificate pool (%w)", err)
 	}
 
-	cli := &http.Client{
+	return &http.Client{
 		Transport: &http.Transport{
 			Proxy:                 http.ProxyFromEnvironment,
 			ForceAttemptHTTP2:     true,
@@ -48,28 +147,27 @@ func NewClient(logger hublog.Logger, accessToken string) (Client, error) {
 				MinVersion: tls.VersionTLS13,
 			},
 		},
-	}
-
-	return &client{
-		logger:           logger,
-		accessToken:      accessToken,
-		cli:              cli,
-		repoContentCache: map[string][]RepoDirEntry{},
 	}, nil
 }
 
 type client struct {
 	accessToken string
+	baseURL     string
+	graphQLURL  string
 	cli         *http.Client
 	logger      hublog.Logger
 
-	repoContentCache map[string][]RepoDirEntry
+	repoContentCache  map[string][]RepoDirEntry
+	repoMetadataCache map[string]map[string]*RepoMetadata
+	authenticatedUser *User
+
+	orgWorkflowPermissionsCache map[string]*WorkflowPermissions
 }
 
 func (c *client) RepoVulnerabilityAlertsEnabled(login string, repoName string) (bool, error) {
 	statusCode, _, body, err := c.request(
 		"GET",
-		fmt.Sprintf("https://api.github.com/repos/%s/%s/vulnerability-alerts", login, repoName),
+		fmt.Sprintf("%srepos/%s/%s/vulnerability-alerts", c.baseURL, url.PathEscape(login), url.PathEscape(repoName)),
 	)
 	if err != nil {
 		return false, fmt.Errorf("failed to query repository %s vulnerability alert settings (%w)", repoName, err)
@@ -113,6 +211,113 @@ func (c *client) GetGitHubActionsRepoPermissions(login string, repoName string)
 	return resp, nil
 }
 
+func (c *client) GetGitHubActionsOrgSelectedActions(login string) (*SelectedActions, error) {
+	resp := &SelectedActions{}
+	if err := getRequest(
+		c,
+		"GET",
+		"orgs/"+url.PathEscape(login)+"/actions/permissions/selected-actions",
+		resp,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch allowed GitHub Actions for organization %s. (%w)",
+			login,
+			err,
+		)
+	}
+	return resp, nil
+}
+
+func (c *client) GetGitHubActionsRepoSelectedActions(login string, repoName string) (*SelectedActions, error) {
+	resp := &SelectedActions{}
+	if err := getRequest(
+		c,
+		"GET",
+		"repos/"+url.PathEscape(login)+"/"+url.PathEscape(repoName)+"/actions/permissions/selected-actions",
+		resp,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch allowed GitHub Actions for repo %s/%s. (%w)",
+			login,
+			repoName,
+			err,
+		)
+	}
+	return resp, nil
+}
+
+func (c *client) GetGitHubActionsOrgForkPRContributorApproval(login string) (*ForkPRContributorApproval, error) {
+	resp := &ForkPRContributorApproval{}
+	if err := getRequest(
+		c,
+		"GET",
+		"orgs/"+url.PathEscape(login)+"/actions/permissions/fork-pr-contributor-approval",
+		resp,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch fork pull request workflow approval settings for organization %s. (%w)",
+			login,
+			err,
+		)
+	}
+	return resp, nil
+}
+
+func (c *client) GetGitHubActionsRepoForkPRContributorApproval(
+	login string,
+	repoName string,
+) (*ForkPRContributorApproval, error) {
+	resp := &ForkPRContributorApproval{}
+	if err := getRequest(
+		c,
+		"GET",
+		"repos/"+url.PathEscape(login)+"/"+url.PathEscape(repoName)+"/actions/permissions/fork-pr-contributor-approval",
+		resp,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch fork pull request workflow approval settings for repo %s/%s. (%w)",
+			login,
+			repoName,
+			err,
+		)
+	}
+	return resp, nil
+}
+
+func (c *client) GetGitHubActionsOrgWorkflowPermissions(login string) (*WorkflowPermissions, error) {
+	if resp, ok := c.orgWorkflowPermissionsCache[login]; ok {
+		return resp, nil
+	}
+	resp := &WorkflowPermissions{}
+	if err := getRequest(c, "GET", "orgs/"+url.PathEscape(login)+"/actions/permissions/workflow", resp); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch GitHub Actions workflow permissions for organization %s. (%w)",
+			login,
+			err,
+		)
+	}
+	c.orgWorkflowPermissionsCache[login] = resp
+	return resp, nil
+}
+
+func (c *client) GetGitHubActionsRepoWorkflowPermissions(login string, repoName string) (*WorkflowPermissions, error) {
+	resp := &WorkflowPermissions{}
+	if err := getRequest(
+		c,
+		"GET",
+		"repos/"+url.PathEscape(login)+"/"+url.PathEscape(repoName)+"/actions/permissions/workflow",
+		resp,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to fetch GitHub Actions workflow permissions for repo %s/%s. (%w)",
+			login,
+			repoName,
+			err,
+		)
+	}
+	return resp, nil
+}
+
 func (c *client) ListOrgRepositories(login string) ([]*Repository, error) {
 	repos, err := listRequest[*Repository](c, "GET", fmt.Sprintf("orgs/%s/repos", url.PathEscape(login)))
 	if err != nil {
@@ -125,14 +330,436 @@ func (c *client) ListOrgRepositories(login string) ([]*Repository, error) {
 	return repos, nil
 }
 
+func (c *client) ListOrgTeams(login string) ([]*Team, error) {
+	teams, err := listRequest[*Team](c, "GET", fmt.Sprintf("orgs/%s/teams", url.PathEscape(login)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list teams of organization %s. (%w)", login, err)
+	}
+	return teams, nil
+}
+
+func (c *client) ListTeamMembers(login string, teamSlug string, role string) ([]*OrgMember, error) {
+	members, err := listRequest[*OrgMember](
+		c,
+		"GET",
+		fmt.Sprintf(
+			"orgs/%s/teams/%s/members?role=%s",
+			url.PathEscape(login),
+			url.PathEscape(teamSlug),
+			url.QueryEscape(role),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list members of team %s in organization %s. (%w)", teamSlug, login, err)
+	}
+	for _, member := range members {
+		member.client = c
+	}
+	return members, nil
+}
+
+func (c *client) ListTeamRepositories(login string, teamSlug string) ([]*TeamRepository, error) {
+	repos, err := listRequest[*TeamRepository](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/teams/%s/repos", url.PathEscape(login), url.PathEscape(teamSlug)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list repositories of team %s in organization %s. (%w)", teamSlug, login, err)
+	}
+	return repos, nil
+}
+
+func (c *client) GetAuthenticatedUser() (*User, error) {
+	if c.authenticatedUser != nil {
+		return c.authenticatedUser, nil
+	}
+	user := &User{}
+	if err := getRequest(c, "GET", "user", user); err != nil {
+		return nil, fmt.Errorf("Failed to fetch the authenticated user. (%w)", err)
+	}
+	user.client = c
+	c.authenticatedUser = user
+	return user, nil
+}
+
+func (c *client) GetUser(login string) (*User, error) {
+	user := &User{}
+	if err := getRequest(c, "GET", "users/"+url.PathEscape(login), user); err != nil {
+		return nil, fmt.Errorf("Failed to fetch user %s. (%w)", login, err)
+	}
+	user.client = c
+	return user, nil
+}
+
+func (c *client) GetLastUserEvent(login string) (*Event, error) {
+	var events []*Event
+	if err := getRequest(c, "GET", "users/"+url.PathEscape(login)+"/events?per_page=1", &events); err != nil {
+		return nil, fmt.Errorf("Failed to fetch the events of user %s. (%w)", login, err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return events[0], nil
+}
+
+func (c *client) ListUserRepositories(login string) ([]*Repository, error) {
+	authenticatedUser, err := c.GetAuthenticatedUser()
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("users/%s/repos?type=owner", url.PathEscape(login))
+	if strings.EqualFold(authenticatedUser.Login, login) {
+		// The public endpoint does not return private repositories, even for the authenticated user.
+		path = "user/repos?affiliation=owner"
+	}
+	repos, err := listRequest[*Repository](c, "GET", path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list repositories of user %s. (%w)", login, err)
+	}
+	for _, repo := range repos {
+		repo.client = c
+		repo.orgLogin = login
+	}
+	return repos, nil
+}
+
 func (c *client) ListOrgAdmins(id string) ([]*OrgMember, error) {
 	members, err := listRequest[*OrgMember](c, "GET", fmt.Sprintf("orgs/%s/members?role=admin", url.PathEscape(id)))
 	if err != nil {
 		return nil, fmt.Errorf("Failed to list organization %s members. (%w)", id, err)
 	}
+	for _, member := range members {
+		member.client = c
+	}
+	return members, nil
+}
+
+func (c *client) GetLastAuditLogEntry(login string, actor string) (*AuditLogEntry, error) {
+	var entries []*AuditLogEntry
+	if err := getRequest(
+		c,
+		"GET",
+		fmt.Sprintf(
+			"orgs/%s/audit-log?phrase=%s&order=desc&per_page=1",
+			url.PathEscape(login),
+			url.QueryEscape("actor:"+actor),
+		),
+		&entries,
+	); err != nil {
+		return nil, fmt.Errorf("Failed to fetch the audit log of organization %s. (%w)", login, err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
+
+func (c *client) ListOrgHooks(login string) ([]*Hook, error) {
+	hooks, err := listRequest[*Hook](c, "GET", fmt.Sprintf("orgs/%s/hooks", url.PathEscape(login)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list webhooks of organization %s. (%w)", login, err)
+	}
+	return hooks, nil
+}
+
+func (c *client) ListOrgInstallations(login string) ([]*Installation, error) {
+	installations, err := listWrappedRequest[*Installation](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/installations", url.PathEscape(login)),
+		"installations",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list GitHub App installations of organization %s. (%w)", login, err)
+	}
+	return installations, nil
+}
+
+func (c *client) ListOrgCustomRepoRoles(login string) ([]*CustomRepoRole, error) {
+	roles, err := listWrappedRequest[*CustomRepoRole](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/custom-repository-roles", url.PathEscape(login)),
+		"custom_roles",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list custom repository roles of organization %s. (%w)", login, err)
+	}
+	return roles, nil
+}
+
+func (c *client) ListOrgBlockedUsers(login string) ([]*OrgMember, error) {
+	users, err := listRequest[*OrgMember](c, "GET", fmt.Sprintf("orgs/%s/blocks", url.PathEscape(login)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list blocked users of organization %s. (%w)", login, err)
+	}
+	for _, user := range users {
+		user.client = c
+	}
+	return users, nil
+}
+
+func (c *client) GetOrgInteractionLimits(login string) (*InteractionLimit, error) {
+	limit := &InteractionLimit{}
+	if err := getRequest(
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/interaction-limits", url.PathEscape(login)),
+		limit,
+	); err != nil {
+		return nil, fmt.Errorf("Failed to fetch interaction limits of organization %s. (%w)", login, err)
+	}
+	return limit, nil
+}
+
+func (c *client) ListOrgInvitations(login string) ([]*Invitation, error) {
+	invitations, err := listRequest[*Invitation](c, "GET", fmt.Sprintf("orgs/%s/invitations", url.PathEscape(login)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list pending invitations of organization %s. (%w)", login, err)
+	}
+	return invitations, nil
+}
+
+func (c *client) ListOrgFailedInvitations(login string) ([]*Invitation, error) {
+	invitations, err := listRequest[*Invitation](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/failed_invitations", url.PathEscape(login)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list failed invitations of organization %s. (%w)", login, err)
+	}
+	return invitations, nil
+}
+
+func (c *client) ListOrgRulesets(login string) ([]*Ruleset, error) {
+	rulesets, err := listRequest[*Ruleset](c, "GET", fmt.Sprintf("orgs/%s/rulesets", url.PathEscape(login)))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list rulesets of organization %s. (%w)", login, err)
+	}
+	return rulesets, nil
+}
+
+func (c *client) GetOrgRuleset(login string, rulesetID int64) (*Ruleset, error) {
+	ruleset := &Ruleset{}
+	if err := getRequest(
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/rulesets/%d", url.PathEscape(login), rulesetID),
+		ruleset,
+	); err != nil {
+		return nil, fmt.Errorf("Failed to fetch ruleset %d of organization %s. (%w)", rulesetID, login, err)
+	}
+	return ruleset, nil
+}
+
+func (c *client) ListOrgRunnerGroups(login string) ([]*RunnerGroup, error) {
+	groups, err := listWrappedRequest[*RunnerGroup](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/actions/runner-groups", url.PathEscape(login)),
+		"runner_groups",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list runner groups of organization %s. (%w)", login, err)
+	}
+	return groups, nil
+}
+
+func (c *client) ListOrgSecrets(login string, secretType SecretType) ([]*Secret, error) {
+	secrets, err := listWrappedRequest[*Secret](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/%s/secrets", url.PathEscape(login), string(secretType)),
+		"secrets",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list %s secrets of organization %s. (%w)", secretType, login, err)
+	}
+	return secrets, nil
+}
+
+func (c *client) ListOrgSecretRepositories(login string, secretType SecretType, name string) ([]*Repository, error) {
+	repos, err := listWrappedRequest[*Repository](
+		c,
+		"GET",
+		fmt.Sprintf(
+			"orgs/%s/%s/secrets/%s/repositories",
+			url.PathEscape(login),
+			string(secretType),
+			url.PathEscape(name),
+		),
+		"repositories",
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Failed to list repositories of %s secret %s of organization %s. (%w)",
+			secretType,
+			name,
+			login,
+			err,
+		)
+	}
+	for _, repo := range repos {
+		repo.client = c
+		repo.orgLogin = login
+	}
+	return repos, nil
+}
+
+func (c *client) ListOrgVariables(login string) ([]*Variable, error) {
+	variables, err := listWrappedRequest[*Variable](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/actions/variables", url.PathEscape(login)),
+		"variables",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list variables of organization %s. (%w)", login, err)
+	}
+	return variables, nil
+}
+
+func (c *client) ListOrgVariableRepositories(login string, name string) ([]*Repository, error) {
+	repos, err := listWrappedRequest[*Repository](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/actions/variables/%s/repositories", url.PathEscape(login), url.PathEscape(name)),
+		"repositories",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list repositories of variable %s of organization %s. (%w)", name, login, err)
+	}
+	for _, repo := range repos {
+		repo.client = c
+		repo.orgLogin = login
+	}
+	return repos, nil
+}
+
+func (c *client) ListOrgRunnerGroupRunners(login string, groupID int64) ([]*Runner, error) {
+	runners, err := listWrappedRequest[*Runner](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/actions/runner-groups/%d/runners", url.PathEscape(login), groupID),
+		"runners",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list runners of runner group %d of organization %s. (%w)", groupID, login, err)
+	}
+	return runners, nil
+}
+
+func (c *client) ListRecentOrgHookDeliveries(login string, hookID int64) ([]*HookDelivery, error) {
+	var deliveries []*HookDelivery
+	if err := getRequest(
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/hooks/%d/deliveries?per_page=%d", url.PathEscape(login), hookID, recentHookDeliveries),
+		&deliveries,
+	); err != nil {
+		return nil, fmt.Errorf("Failed to list deliveries of webhook %d of organization %s. (%w)", hookID, login, err)
+	}
+	return deliveries, nil
+}
+
+func (c *client) ListOrgMembers(login string, filter string) ([]*OrgMember, error) {
+	members, err := listRequest[*OrgMember](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/members?filter=%s", url.PathEscape(login), url.QueryEscape(filter)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list organization %s members. (%w)", login, err)
+	}
+	for _, member := range members {
+		member.client = c
+	}
 	return members, nil
 }
 
+func (c *client) ListOrgOutsideCollaborators(login string, filter string) ([]*OrgMember, error) {
+	collaborators, err := listRequest[*OrgMember](
+		c,
+		"GET",
+		fmt.Sprintf("orgs/%s/outside_collaborators?filter=%s", url.PathEscape(login), url.QueryEscape(filter)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list outside collaborators of organization %s. (%w)", login, err)
+	}
+	for _, collaborator := range collaborators {
+		collaborator.client = c
+	}
+	return collaborators, nil
+}
+
+func (c *client) ListRepoDeployKeys(login string, repoName string) ([]*DeployKey, error) {
+	keys, err := listRequest[*DeployKey](
+		c,
+		"GET",
+		fmt.Sprintf("repos/%s/%s/keys", url.PathEscape(login), url.PathEscape(repoName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list deploy keys of repo %s/%s. (%w)", login, repoName, err)
+	}
+	return keys, nil
+}
+
+func (c *client) ListRepoHooks(login string, repoName string) ([]*Hook, error) {
+	hooks, err := listRequest[*Hook](
+		c,
+		"GET",
+		fmt.Sprintf("repos/%s/%s/hooks", url.PathEscape(login), url.PathEscape(repoName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list webhooks of repo %s/%s. (%w)", login, repoName, err)
+	}
+	return hooks, nil
+}
+
+func (c *client) ListRecentRepoHookDeliveries(login string, repoName string, hookID int64) ([]*HookDelivery, error) {
+	var deliveries []*HookDelivery
+	if err := getRequest(
+		c,
+		"GET",
+		fmt.Sprintf(
+			"repos/%s/%s/hooks/%d/deliveries?per_page=%d",
+			url.PathEscape(login),
+			url.PathEscape(repoName),
+			hookID,
+			recentHookDeliveries,
+		),
+		&deliveries,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"Failed to list deliveries of webhook %d of repo %s/%s. (%w)",
+			hookID,
+			login,
+			repoName,
+			err,
+		)
+	}
+	return deliveries, nil
+}
+
+func (c *client) ListRepoCollaborators(login string, repoName string, affiliation string) ([]*Collaborator, error) {
+	collaborators, err := listRequest[*Collaborator](
+		c,
+		"GET",
+		fmt.Sprintf(
+			"repos/%s/%s/collaborators?affiliation=%s",
+			url.PathEscape(login),
+			url.PathEscape(repoName),
+			url.QueryEscape(affiliation),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list collaborators of repo %s/%s. (%w)", login, repoName, err)
+	}
+	return collaborators, nil
+}
+
 func (c *client) GetGitHubActionsOrgPermissions(id string) (*ActionsPermissions, error) {
 	resp := &ActionsPermissions{}
 	if err := getRequest(c, "GET", "orgs/"+url.PathEscape(id)+"/actions/permissions", resp); err != nil {
@@ -178,9 +805,9 @@ const (
 
 //goland:noinspection GoVetStructTag
 type RepoDirEntry struct {
-	c      *client `json:"-"`
-	orgID  string  `json:"-"`
-	repoID string  `json:"-"`
+	c      Client `json:"-"`
+	orgID  string `json:"-"`
+	repoID string `json:"-"`
 
 	Type FileType `json:"type"`
 	Size int      `json:"size"`
@@ -189,6 +816,15 @@ type RepoDirEntry struct {
 	Sha  string   `json:"sha"`
 }
 
+// NewRepoDirEntry binds a directory entry of the specified repository to a client, so that its contents can be
+// fetched. This is useful for constructing test data.
+func NewRepoDirEntry(c Client, ownerLogin string, repoName string, entry RepoDirEntry) RepoDirEntry {
+	entry.c = c
+	entry.orgID = ownerLogin
+	entry.repoID = repoName
+	return entry
+}
+
 func (e *RepoDirEntry) GetContents() ([]byte, error) {
 	if e.Type != FileTypeFile {
 		return nil, fmt.Errorf("Bug: Non-file types cannot be fetched (%s).", e.Path)
@@ -259,11 +895,23 @@ type errorResponse struct {
 }
 
 func (c *client) request(method string, url string) (statusCode int, headers http.Header, body []byte, err error) {
+	return c.requestWithBody(method, url, nil)
+}
+
+func (c *client) requestWithBody(method string, url string, requestBody []byte) (
+	statusCode int,
+	headers http.Header,
+	body []byte,
+	err error,
+) {
 	c.logger.WithLevel(hublog.Debug).Logf("HTTP --> %s %s", method, url)
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequest(method, url, bytes.NewReader(requestBody))
 	if err != nil {
 		return 0, nil, nil, fmt.Errorf("failed to construct HTTP request (%w)", err)
 	}
+	if requestBody != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
 	req.Header.Add("Accept", "application/vnd.github.v3+json")
 	req.Header.Add("Authorization", "token "+c.accessToken)
 	req.Header.Add("User-Agent", "HubCheck")
@@ -286,7 +934,7 @@ func (c *client) request(method string, url string) (statusCode int, headers htt
 }
 
 func getRequest[T any](c *client, method string, path string, responseObject *T) error {
-	status, _, body, err := c.request(method, "https://api.github.com/"+path)
+	status, headers, body, err := c.request(method, c.baseURL+path)
 	if err != nil {
 		return err
 	}
@@ -295,20 +943,7 @@ func getRequest[T any](c *client, method string, path string, responseObject *T)
 	switch status {
 	case 200:
 	default:
-		var errDetails *errorResponse
-		if err := decoder.Decode(errDetails); err != nil {
-			return fmt.Errorf(
-				"unexpected HTTP response code: %d (%s)",
-				status,
-				body,
-			)
-		}
-		return fmt.Errorf(
-			"unexpected HTTP response code: %d (%s; %s )",
-			status,
-			errDetails.Message,
-			errDetails.DocumentationURL,
-		)
+		return newAPIError(status, headers, body)
 	}
 
 	if err := decoder.Decode(responseObject); err != nil {
@@ -321,7 +956,7 @@ func getRequest[T any](c *client, method string, path string, responseObject *T)
 // listRequest lists items of a certain type while observing pagination.
 // This is a non-receiver method due to https://github.com/golang/go/issues/49085
 func listRequest[T any](c *client, method string, path string) ([]T, error) {
-	nextLink := "https://api.github.com/" + path
+	nextLink := c.baseURL + path
 	var result []T
 	for {
 		status, headers, body, err := c.request(method, nextLink)
@@ -333,36 +968,10 @@ func listRequest[T any](c *client, method string, path string) ([]T, error) {
 		switch status {
 		case 200:
 		default:
-			var errDetails *errorResponse
-			if err := decoder.Decode(errDetails); err != nil {
-				return nil, fmt.Errorf(
-					"unexpected HTTP response code: %d (%s)",
-					status,
-					body,
-				)
-			}
-			return nil, fmt.Errorf(
-				"unexpected HTTP response code: %d (%s; %s )",
-				status,
-				errDetails.Message,
-				errDetails.DocumentationURL,
-			)
+			return nil, newAPIError(status, headers, body)
 		}
 
-		nextLink = ""
-		linkHeader := headers.Get("Link")
-		if linkHeader != "" {
-			linkHeaderParts := strings.Split(linkHeader, ",")
-			for _, linkHeaderPart := range linkHeaderParts {
-				parts := strings.SplitN(linkHeaderPart, ";", 2)
-				if len(parts) == 2 {
-					relPart := strings.TrimSpace(parts[1])
-					if relPart == "rel=\"next\"" {
-						nextLink = strings.Trim(parts[0], "<>")
-					}
-				}
-			}
-		}
+		nextLink = nextPageLink(headers)
 
 		var items []T
 		if err := decoder.Decode(&items); err != nil {
@@ -379,3 +988,59 @@ func listRequest[T any](c *client, method string, path string) ([]T, error) {
 		}
 	}
 }
+
+// listWrappedRequest lists items of endpoints that wrap the items in an object, such as
+// {"total_count": 1, "installations": [...]}, while observing pagination.
+//
+// This is a non-receiver method due to https://github.com/golang/go/issues/49085
+func listWrappedRequest[T any](c *client, method string, path string, field string) ([]T, error) {
+	nextLink := c.baseURL + path
+	var result []T
+	for {
+		status, headers, body, err := c.request(method, nextLink)
+		if err != nil {
+			return nil, err
+		}
+		if status != 200 {
+			return nil, newAPIError(status, headers, body)
+		}
+
+		var wrapper map[string]json.RawMessage
+		if err := json.Unmarshal(body, &wrapper); err != nil {
+			return nil, fmt.Errorf("failed to decode GitHub response (%v; %s)", err, body)
+		}
+		var items []T
+		if data, ok := wrapper[field]; ok {
+			if err := json.Unmarshal(data, &items); err != nil {
+				return nil, fmt.Errorf("failed to decode GitHub response (%v; %s)", err, body)
+			}
+		}
+		if len(items) == 0 {
+			return result, nil
+		}
+		result = append(result, items...)
+
+		nextLink = nextPageLink(headers)
+		if nextLink == "" {
+			return result, nil
+		}
+	}
+}
+
+// nextPageLink returns the URL of the next page from the Link header, or an empty string on the last page.
+func nextPageLink(headers http.Header) string {
+	linkHeader := headers.Get("Link")
+	if linkHeader == "" {
+		return ""
+	}
+	for _, linkHeaderPart := range strings.Split(linkHeader, ",") {
+		parts := strings.SplitN(linkHeaderPart, ";", 2)
+		if len(parts) == 2 {
+			relPart := strings.TrimSpace(parts[1])
+			if relPart == "rel=\"next\"" {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package github
+
+const (
+	// AffiliationOutside selects outside collaborators of organization repositories.
+	AffiliationOutside = "outside"
+	// AffiliationDirect selects collaborators with direct permissions on a repository.
+	AffiliationDirect = "direct"
+	// AffiliationAll selects all collaborators, including those with access through teams or organization
+	// membership.
+	AffiliationAll = "all"
+)
+
+const (
+	// MemberFilterAll lists all members.
+	MemberFilterAll = "all"
+	// MemberFilter2FADisabled lists members who don't have two-factor authentication enabled. Only organization
+	// owners can use this filter.
+	MemberFilter2FADisabled = "2fa_disabled"
+)
+
+// RepoPermissions are the permissions of a user on a repository.
+type RepoPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
+// Role returns the name of the highest base role the permissions belong to.
+func (p RepoPermissions) Role() string {
+	switch {
+	case p.Admin:
+		return "admin"
+	case p.Maintain:
+		return "maintain"
+	case p.Push:
+		return "write"
+	case p.Triage:
+		return "triage"
+	default:
+		return "read"
+	}
+}
+
+// Collaborator is a user with access to a repository.
+type Collaborator struct {
+	OrgMember
+
+	Permissions RepoPermissions `json:"permissions"`
+	// RoleName is the name of the role, for example "admin", "write" or the name of a custom repository role.
+	RoleName string `json:"role_name"`
+}
+
+// Role returns the role name of the collaborator. If the API didn't return one, it is derived from the permissions.
+func (c Collaborator) Role() string {
+	if c.RoleName != "" {
+		return c.RoleName
+	}
+	return c.Permissions.Role()
+}
@@ -0,0 +1,13 @@
+package github
+
+// CustomRepoRole is a custom repository role of an organization. It extends a base role with fine-grained
+// permissions.
+type CustomRepoRole struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// BaseRole is the role the custom role extends: "read", "triage", "write" or "maintain".
+	BaseRole string `json:"base_role"`
+	// Permissions lists the fine-grained permissions added to the base role, for example "manage_webhooks".
+	Permissions []string `json:"permissions"`
+}
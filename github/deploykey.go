@@ -0,0 +1,16 @@
+package github
+
+import "time"
+
+// DeployKey is an SSH key granting access to a single repository.
+type DeployKey struct {
+	Id int64 `json:"id"`
+	// Key is the public key.
+	Key       string     `json:"key"`
+	Title     string     `json:"title"`
+	Verified  bool       `json:"verified"`
+	ReadOnly  bool       `json:"read_only"`
+	CreatedAt time.Time  `json:"created_at"`
+	AddedBy   string     `json:"added_by"`
+	LastUsed  *time.Time `json:"last_used"`
+}
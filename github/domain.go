@@ -0,0 +1,85 @@
+package github
+
+import (
+	"fmt"
+)
+
+const orgDomainsQuery = `query($login: String!, $cursor: String) {
+  organization(login: $login) {
+    notificationDeliveryRestrictionEnabledSetting
+    domains(first: 100, after: $cursor) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        domain
+        isVerified
+        isApproved
+      }
+    }
+  }
+}`
+
+// OrgDomain is a domain of an organization. Verified domains belong to the organization, approved domains are
+// allowed to receive email notifications without being verified.
+type OrgDomain struct {
+	Domain     string `json:"domain"`
+	IsVerified bool   `json:"isVerified"`
+	IsApproved bool   `json:"isApproved"`
+}
+
+// OrgDomainSettings are the domains of an organization and the email notification settings depending on them. They
+// are only available through the GraphQL API.
+type OrgDomainSettings struct {
+	Domains []OrgDomain `json:"domains"`
+	// NotificationsRestricted is true if email notifications are only sent to verified or approved domains.
+	NotificationsRestricted bool `json:"notifications_restricted"`
+}
+
+type orgDomainsResponse struct {
+	Organization *struct {
+		NotificationDeliveryRestrictionEnabledSetting string `json:"notificationDeliveryRestrictionEnabledSetting"`
+		Domains                                       *struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []OrgDomain `json:"nodes"`
+		} `json:"domains"`
+	} `json:"organization"`
+}
+
+func (c *client) GetOrgDomainSettings(login string) (*OrgDomainSettings, error) {
+	result := &OrgDomainSettings{}
+	var cursor *string
+	for {
+		response := orgDomainsResponse{}
+		if err := graphQLRequest(
+			c,
+			orgDomainsQuery,
+			map[string]interface{}{
+				"login":  login,
+				"cursor": cursor,
+			},
+			&response,
+		); err != nil {
+			return nil, fmt.Errorf("Failed to fetch domains of organization %s. (%w)", login, err)
+		}
+		if response.Organization == nil {
+			return nil, fmt.Errorf("Organization %s not found.", login)
+		}
+		// The domains are only returned to organization admins.
+		if response.Organization.Domains == nil {
+			return nil, fmt.Errorf("Failed to fetch domains of organization %s, are you an admin?", login)
+		}
+		result.NotificationsRestricted = response.Organization.NotificationDeliveryRestrictionEnabledSetting == "ENABLED"
+		domains := response.Organization.Domains
+		result.Domains = append(result.Domains, domains.Nodes...)
+		if !domains.PageInfo.HasNextPage {
+			return result, nil
+		}
+		endCursor := domains.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+)
+
+const enterpriseOrganizationsQuery = `query($slug: String!, $cursor: String) {
+  enterprise(slug: $slug) {
+    organizations(first: 100, after: $cursor) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        login
+      }
+    }
+  }
+}`
+
+type enterpriseOrganizationsResponse struct {
+	Enterprise *struct {
+		Organizations struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []struct {
+				Login string `json:"login"`
+			} `json:"nodes"`
+		} `json:"organizations"`
+	} `json:"enterprise"`
+}
+
+func (c *client) ListEnterpriseOrganizations(slug string) ([]string, error) {
+	var result []string
+	var cursor *string
+	for {
+		response := enterpriseOrganizationsResponse{}
+		if err := graphQLRequest(
+			c,
+			enterpriseOrganizationsQuery,
+			map[string]interface{}{
+				"slug":   slug,
+				"cursor": cursor,
+			},
+			&response,
+		); err != nil {
+			return nil, fmt.Errorf("Failed to list organizations of enterprise %s. (%w)", slug, err)
+		}
+		if response.Enterprise == nil {
+			return nil, fmt.Errorf("Enterprise %s not found.", slug)
+		}
+		orgs := response.Enterprise.Organizations
+		for _, node := range orgs.Nodes {
+			result = append(result, node.Login)
+		}
+		if !orgs.PageInfo.HasNextPage {
+			return result, nil
+		}
+		endCursor := orgs.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+}
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when the GitHub API responds with an unexpected HTTP status code.
+type APIError struct {
+	StatusCode       int
+	Message          string
+	DocumentationURL string
+	// RateLimitRemaining is the X-RateLimit-Remaining header of the response, or empty if it was not sent.
+	RateLimitRemaining string
+	// RetryAfter is the Retry-After header GitHub sends when a secondary rate limit is hit, or empty if it was not
+	// sent.
+	RetryAfter string
+
+	body []byte
+}
+
+func newAPIError(statusCode int, headers http.Header, body []byte) *APIError {
+	result := &APIError{
+		StatusCode:         statusCode,
+		RateLimitRemaining: headers.Get("X-RateLimit-Remaining"),
+		RetryAfter:         headers.Get("Retry-After"),
+		body:               body,
+	}
+	errDetails := &errorResponse{}
+	if err := json.Unmarshal(body, errDetails); err == nil {
+		result.Message = errDetails.Message
+		result.DocumentationURL = errDetails.DocumentationURL
+	}
+	return result
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("unexpected HTTP response code: %d (%s)", e.StatusCode, e.body)
+	}
+	return fmt.Sprintf("unexpected HTTP response code: %d (%s; %s )", e.StatusCode, e.Message, e.DocumentationURL)
+}
+
+// IsNotFound returns true if the error was caused by a 404 response from the API. GitHub also responds with a 404 if
+// an endpoint does not exist, for example on older GitHub Enterprise Server versions.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// RateLimited returns true if the response was caused by an exhausted primary or secondary rate limit.
+func (e *APIError) RateLimited() bool {
+	if e.StatusCode != http.StatusForbidden && e.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return e.RateLimitRemaining == "0" || e.RetryAfter != ""
+}
+
+// IsForbidden returns true if the error was caused by a 403 response from the API. GitHub responds with a 403 if the
+// token lacks a scope or the user lacks a role an endpoint requires. GitHub also uses a 403 when the rate limit is
+// exhausted, these responses are not considered forbidden so rules don't mistake them for missing permissions.
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden && !apiErr.RateLimited()
+}
+
+// IsRateLimited returns true if the error was caused by an exhausted rate limit.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.RateLimited()
+}
@@ -0,0 +1,82 @@
+package githubmock_test
+
+import (
+	"reflect"
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubmock"
+)
+
+func TestNotMocked(t *testing.T) {
+	c := &githubmock.Client{}
+	if _, err := c.GetOrg("acme"); err == nil {
+		t.Fatal("a method without a function did not return an error")
+	}
+	calls := c.Calls()
+	if len(calls) != 1 || calls[0].Method != "GetOrg" || !reflect.DeepEqual(calls[0].Args, []interface{}{"acme"}) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
+
+func TestNewOrganization(t *testing.T) {
+	c := &githubmock.Client{}
+	c.ListOrgRepositoriesFunc = func(login string) ([]*github.Repository, error) {
+		return []*github.Repository{
+			github.NewRepository(c, login, github.Repository{Name: "app"}),
+		}, nil
+	}
+	c.GetGitHubActionsRepoPermissionsFunc = func(login string, repoName string) (*github.ActionsPermissions, error) {
+		return &github.ActionsPermissions{AllowedActions: login + "/" + repoName}, nil
+	}
+
+	org := github.NewOrganization(c, github.Organization{Login: "acme"})
+	repos, err := org.ListRepositories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].Name != "app" {
+		t.Fatalf("unexpected repositories: %v", repos)
+	}
+	permissions, err := repos[0].GetActionsPermissions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if permissions.AllowedActions != "acme/app" {
+		t.Fatalf("the repository was not bound to its owner: %v", permissions)
+	}
+
+	var methods []string
+	for _, call := range c.Calls() {
+		methods = append(methods, call.Method)
+	}
+	if !reflect.DeepEqual(methods, []string{"ListOrgRepositories", "GetGitHubActionsRepoPermissions"}) {
+		t.Fatalf("unexpected calls: %v", methods)
+	}
+}
+
+func TestNewRepoDirEntry(t *testing.T) {
+	c := &githubmock.Client{
+		GetContentsFunc: func(login string, repoName string, path string) ([]byte, error) {
+			return []byte(login + "/" + repoName + "/" + path), nil
+		},
+	}
+
+	entry := github.NewRepoDirEntry(c, "acme", "app", github.RepoDirEntry{
+		Type: github.FileTypeFile,
+		Name: "README.md",
+		Path: "README.md",
+	})
+	content, err := entry.GetContents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "acme/app/README.md" {
+		t.Fatalf("unexpected content: %s", content)
+	}
+
+	dir := github.NewRepoDirEntry(c, "acme", "app", github.RepoDirEntry{Type: github.FileTypeDir, Path: "docs"})
+	if _, err := dir.GetContents(); err == nil {
+		t.Fatal("the contents of a directory were fetched")
+	}
+}
@@ -0,0 +1,151 @@
+package githubtest
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+type contentsFile struct {
+	github.RepoDirEntry
+
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Sha  string `json:"sha"`
+	Size int    `json:"size,omitempty"`
+}
+
+type treeResponse struct {
+	Sha       string      `json:"sha"`
+	Tree      []treeEntry `json:"tree"`
+	Truncated bool        `json:"truncated"`
+}
+
+// blobSha calculates the git object ID of a file the same way git does.
+func blobSha(content string) string {
+	h := sha1.New()
+	_, _ = fmt.Fprintf(h, "blob %d\x00%s", len(content), content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// dirSha returns a stable identifier for a directory. It is not a real git tree ID.
+func dirSha(dirPath string) string {
+	h := sha1.Sum([]byte("tree " + dirPath))
+	return hex.EncodeToString(h[:])
+}
+
+// dirEntries returns the direct children of a directory in the repository, or nil if the directory does not exist.
+func dirEntries(repo *Repository, dirPath string) []github.RepoDirEntry {
+	found := dirPath == ""
+	seen := map[string]bool{}
+	var result []github.RepoDirEntry
+	for filePath, content := range repo.Files {
+		rest := filePath
+		if dirPath != "" {
+			if !strings.HasPrefix(filePath, dirPath+"/") {
+				continue
+			}
+			rest = strings.TrimPrefix(filePath, dirPath+"/")
+		}
+		found = true
+		name, _, isDir := strings.Cut(rest, "/")
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		entryPath := path.Join(dirPath, name)
+		if isDir {
+			result = append(result, github.RepoDirEntry{
+				Type: github.FileTypeDir,
+				Name: name,
+				Path: entryPath,
+				Sha:  dirSha(entryPath),
+			})
+		} else {
+			result = append(result, github.RepoDirEntry{
+				Type: github.FileTypeFile,
+				Size: len(content),
+				Name: name,
+				Path: entryPath,
+				Sha:  blobSha(content),
+			})
+		}
+	}
+	if !found {
+		return nil
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return append([]github.RepoDirEntry{}, result...)
+}
+
+func serveContents(w http.ResponseWriter, repo *Repository, filePath string) {
+	if content, ok := repo.Files[filePath]; ok {
+		writeJSON(w, http.StatusOK, contentsFile{
+			RepoDirEntry: github.RepoDirEntry{
+				Type: github.FileTypeFile,
+				Size: len(content),
+				Name: path.Base(filePath),
+				Path: filePath,
+				Sha:  blobSha(content),
+			},
+			Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+			Encoding: "base64",
+		})
+		return
+	}
+	entries := dirEntries(repo, filePath)
+	if entries == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+func serveTree(w http.ResponseWriter, r *http.Request, repo *Repository) {
+	recursive := r.URL.Query().Get("recursive") != ""
+	var tree []treeEntry
+	var walk func(dirPath string)
+	walk = func(dirPath string) {
+		for _, entry := range dirEntries(repo, dirPath) {
+			if entry.Type == github.FileTypeDir {
+				tree = append(tree, treeEntry{
+					Path: entry.Path,
+					Mode: "040000",
+					Type: "tree",
+					Sha:  entry.Sha,
+				})
+				if recursive {
+					walk(entry.Path)
+				}
+			} else {
+				tree = append(tree, treeEntry{
+					Path: entry.Path,
+					Mode: "100644",
+					Type: "blob",
+					Sha:  entry.Sha,
+					Size: entry.Size,
+				})
+			}
+		}
+	}
+	walk("")
+	writeJSON(w, http.StatusOK, treeResponse{
+		Sha:  dirSha(""),
+		Tree: tree,
+	})
+}
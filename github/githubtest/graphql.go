@@ -0,0 +1,255 @@
+package githubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type object map[string]interface{}
+
+// handleGraphQL answers the GraphQL queries hubcheck sends. It does not implement a GraphQL parser, it recognizes
+// queries by the top level fields and serves the fields hubcheck requests.
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var request graphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "Problems parsing JSON")
+		return
+	}
+
+	switch {
+	case strings.Contains(request.Query, "repositoryOwner(") && strings.Contains(request.Query, "repositories("):
+		s.graphQLRepositories(w, request)
+	case strings.Contains(request.Query, "enterprise(") && strings.Contains(request.Query, "organizations("):
+		s.graphQLEnterpriseOrganizations(w, request)
+	case strings.Contains(request.Query, "organization(") && strings.Contains(request.Query, "domains("):
+		s.graphQLOrgDomains(w, request)
+	default:
+		writeGraphQLError(w, "Unsupported query")
+	}
+}
+
+func (s *Server) graphQLRepositories(w http.ResponseWriter, request graphQLRequest) {
+	login, _ := request.Variables["login"].(string)
+	repos, ok := s.state.findOwnerRepos(login)
+	if !ok {
+		writeJSON(w, http.StatusOK, object{
+			"data": object{"repositoryOwner": nil},
+			"errors": []object{
+				{
+					"type":    "NOT_FOUND",
+					"path":    []string{"repositoryOwner"},
+					"message": "Could not resolve to a RepositoryOwner with the login of '" + login + "'.",
+				},
+			},
+		})
+		return
+	}
+
+	pageSize := s.state.PageSize
+	if value, ok := request.Variables["pageSize"].(float64); ok {
+		pageSize = int(value)
+	}
+	if pageSize <= 0 {
+		pageSize = 30
+	}
+	start := 0
+	if cursor, ok := request.Variables["cursor"].(string); ok {
+		start, _ = strconv.Atoi(cursor)
+	}
+	if start > len(repos) {
+		start = len(repos)
+	}
+	end := start + pageSize
+	if end > len(repos) {
+		end = len(repos)
+	}
+
+	nodes := []object{}
+	for _, repo := range repos[start:end] {
+		nodes = append(nodes, graphQLRepository(login, repo))
+	}
+	writeJSON(w, http.StatusOK, object{
+		"data": object{
+			"repositoryOwner": object{
+				"repositories": object{
+					"pageInfo": object{
+						"hasNextPage": end < len(repos),
+						"endCursor":   strconv.Itoa(end),
+					},
+					"nodes": nodes,
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) graphQLEnterpriseOrganizations(w http.ResponseWriter, request graphQLRequest) {
+	slug, _ := request.Variables["slug"].(string)
+	for _, enterprise := range s.state.Enterprises {
+		if enterprise.Slug != slug {
+			continue
+		}
+		nodes := []object{}
+		for _, login := range enterprise.Organizations {
+			nodes = append(nodes, object{"login": login})
+		}
+		writeJSON(w, http.StatusOK, object{
+			"data": object{
+				"enterprise": object{
+					"organizations": object{
+						"pageInfo": object{
+							"hasNextPage": false,
+							"endCursor":   strconv.Itoa(len(nodes)),
+						},
+						"nodes": nodes,
+					},
+				},
+			},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"data": object{"enterprise": nil},
+		"errors": []object{
+			{
+				"type":    "NOT_FOUND",
+				"path":    []string{"enterprise"},
+				"message": "Could not resolve to an Enterprise with the slug of '" + slug + "'.",
+			},
+		},
+	})
+}
+
+func (s *Server) graphQLOrgDomains(w http.ResponseWriter, request graphQLRequest) {
+	login, _ := request.Variables["login"].(string)
+	org := s.state.findOrg(login)
+	if org == nil {
+		writeJSON(w, http.StatusOK, object{
+			"data": object{"organization": nil},
+			"errors": []object{
+				{
+					"type":    "NOT_FOUND",
+					"path":    []string{"organization"},
+					"message": "Could not resolve to an Organization with the login of '" + login + "'.",
+				},
+			},
+		})
+		return
+	}
+	setting := "DISABLED"
+	if org.NotificationsRestricted {
+		setting = "ENABLED"
+	}
+	domains := []github.OrgDomain{}
+	domains = append(domains, org.Domains...)
+	writeJSON(w, http.StatusOK, object{
+		"data": object{
+			"organization": object{
+				"notificationDeliveryRestrictionEnabledSetting": setting,
+				"domains": object{
+					"pageInfo": object{
+						"hasNextPage": false,
+						"endCursor":   strconv.Itoa(len(domains)),
+					},
+					"nodes": domains,
+				},
+			},
+		},
+	})
+}
+
+func graphQLRepository(ownerLogin string, repo *Repository) object {
+	response := repoResponse(ownerLogin, repo)
+	visibility := strings.ToUpper(response.Visibility)
+	topics := []object{}
+	for _, topic := range response.Topics {
+		topics = append(topics, object{"topic": object{"name": topic}})
+	}
+	result := object{
+		"name":             response.Name,
+		"visibility":       visibility,
+		"repositoryTopics": object{"nodes": topics},
+		"licenseInfo":      nil,
+		"defaultBranchRef": nil,
+		"rootTree":         graphQLTree(repo, ""),
+		"githubTree":       graphQLTree(repo, ".github"),
+		"workflowsTree":    graphQLTree(repo, ".github/workflows"),
+		"docsTree":         graphQLTree(repo, "docs"),
+	}
+	if response.License != nil {
+		result["licenseInfo"] = object{
+			"key":    response.License.Key,
+			"name":   response.License.Name,
+			"spdxId": response.License.SPDX,
+			"url":    response.License.URL,
+		}
+	}
+	if len(repo.Files) > 0 {
+		branch := object{
+			"name":                 response.DefaultBranch,
+			"branchProtectionRule": nil,
+		}
+		if p := repo.BranchProtection; p != nil {
+			branch["branchProtectionRule"] = object{
+				"pattern":                      p.Pattern,
+				"requiresApprovingReviews":     p.RequiresApprovingReviews,
+				"requiredApprovingReviewCount": p.RequiredApprovingReviewCount,
+				"requiresCodeOwnerReviews":     p.RequiresCodeOwnerReviews,
+				"dismissesStaleReviews":        p.DismissesStaleReviews,
+				"requiresStatusChecks":         p.RequiresStatusChecks,
+				"requiresStrictStatusChecks":   p.RequiresStrictStatusChecks,
+				"requiresCommitSignatures":     p.RequiresCommitSignatures,
+				"requiresLinearHistory":        p.RequiresLinearHistory,
+				"isAdminEnforced":              p.IsAdminEnforced,
+				"allowsForcePushes":            p.AllowsForcePushes,
+				"allowsDeletions":              p.AllowsDeletions,
+			}
+		}
+		result["defaultBranchRef"] = branch
+	}
+	return result
+}
+
+func graphQLTree(repo *Repository, dirPath string) interface{} {
+	entries := dirEntries(repo, dirPath)
+	if len(repo.Files) == 0 || entries == nil {
+		return nil
+	}
+	result := []object{}
+	for _, entry := range entries {
+		if entry.Type == github.FileTypeDir {
+			result = append(result, object{
+				"name":   entry.Name,
+				"path":   entry.Path,
+				"type":   "tree",
+				"object": object{},
+			})
+		} else {
+			result = append(result, object{
+				"name":   entry.Name,
+				"path":   entry.Path,
+				"type":   "blob",
+				"object": object{"byteSize": entry.Size},
+			})
+		}
+	}
+	return object{"entries": result}
+}
+
+func writeGraphQLError(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, object{
+		"data": nil,
+		"errors": []object{
+			{"message": message},
+		},
+	})
+}
@@ -0,0 +1,653 @@
+// Package githubtest provides an in-memory fake of the subset of the GitHub REST and GraphQL APIs hubcheck uses. It is
+// intended for testing rules end to end without network access.
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobwas/glob"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Fault describes an error the server should return instead of the regular response.
+type Fault struct {
+	// StatusCode is the HTTP status code to return, for example 403 or 404. Defaults to 500.
+	StatusCode int
+	// Message is the error message in the response body.
+	Message string
+	// RateLimited makes the server respond with a 403 and the rate limit headers GitHub sends when the rate limit is
+	// exhausted.
+	RateLimited bool
+	// Times is the number of requests this fault applies to. If it is 0 the fault applies until ClearFaults is
+	// called.
+	Times int
+}
+
+type fault struct {
+	pattern   glob.Glob
+	fault     Fault
+	remaining int
+}
+
+// Server is a fake GitHub API server. Create it using New and close it using Close.
+type Server struct {
+	// URL is the base URL of the API, to be passed to github.NewClient.
+	URL string
+
+	server   *httptest.Server
+	lock     sync.Mutex
+	state    State
+	faults   []*fault
+	requests []string
+}
+
+// New starts a fake GitHub API server with the specified state.
+func New(state State) *Server {
+	s := &Server{
+		state: state,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.server.URL + "/"
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// HTTPClient returns an HTTP client suitable for talking to the server.
+func (s *Server) HTTPClient() *http.Client {
+	return s.server.Client()
+}
+
+// NewClient creates a GitHub client that talks to this server.
+func (s *Server) NewClient(logger hublog.Logger) (github.Client, error) {
+	return github.NewClient(logger, s.state.Token, s.URL, s.HTTPClient())
+}
+
+// Update changes the server state. The function is called while holding the server lock.
+func (s *Server) Update(f func(state *State)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	f(&s.state)
+}
+
+// Inject makes the server return the fault for all requests whose path matches the pattern. The pattern is a glob
+// without the leading slash and query string, for example "repos/acme/*/vulnerability-alerts". The * wildcard does
+// not match across path segments, ** does.
+func (s *Server) Inject(pattern string, f Fault) error {
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return fmt.Errorf("invalid fault pattern %s (%w)", pattern, err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.faults = append(s.faults, &fault{
+		pattern:   g,
+		fault:     f,
+		remaining: f.Times,
+	})
+	return nil
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests the server received so far in the "METHOD path" format.
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+	s.requests = append(s.requests, r.Method+" "+path)
+
+	if s.state.Token != "" && r.Header.Get("Authorization") != "token "+s.state.Token {
+		writeError(w, http.StatusUnauthorized, "Bad credentials")
+		return
+	}
+	if s.applyFault(w, path) {
+		return
+	}
+
+	segments := strings.Split(path, "/")
+	switch {
+	case r.Method == http.MethodGet && match(segments, "user", "orgs"):
+		orgs := make([]github.Organization, len(s.state.Organizations))
+		for i, org := range s.state.Organizations {
+			orgs[i] = org.Organization
+		}
+		writeList(w, r, s.state.PageSize, orgs)
+	case r.Method == http.MethodGet && match(segments, "user"):
+		writeJSON(w, http.StatusOK, s.state.authenticatedUser().User)
+	case r.Method == http.MethodGet && match(segments, "user", "repos"):
+		user := s.state.authenticatedUser()
+		writeRepoList(w, r, s.state.PageSize, user.Login, user.Repositories, false)
+	case r.Method == http.MethodGet && segments[0] == "users":
+		s.handleUser(w, r, segments[1:])
+	case r.Method == http.MethodPost && match(segments, "graphql"):
+		s.handleGraphQL(w, r)
+	case r.Method == http.MethodGet && segments[0] == "orgs":
+		s.handleOrg(w, r, segments[1:])
+	case r.Method == http.MethodGet && segments[0] == "repos" && len(segments) > 2:
+		s.handleRepo(w, r, segments[1:])
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) applyFault(w http.ResponseWriter, path string) bool {
+	for i, f := range s.faults {
+		if !f.pattern.Match(path) {
+			continue
+		}
+		if f.fault.Times > 0 {
+			f.remaining--
+			if f.remaining <= 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		if f.fault.RateLimited {
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Used", "5000")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+			message := f.fault.Message
+			if message == "" {
+				message = "API rate limit exceeded"
+			}
+			writeError(w, http.StatusForbidden, message)
+			return true
+		}
+		statusCode := f.fault.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusInternalServerError
+		}
+		message := f.fault.Message
+		if message == "" {
+			message = http.StatusText(statusCode)
+		}
+		writeError(w, statusCode, message)
+		return true
+	}
+	return false
+}
+
+func (s *Server) handleOrg(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	org := s.state.findOrg(segments[0])
+	if org == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	segments = segments[1:]
+	switch {
+	case len(segments) == 0:
+		writeJSON(w, http.StatusOK, org.Organization)
+	case match(segments, "members"):
+		role := r.URL.Query().Get("role")
+		filter := r.URL.Query().Get("filter")
+		members := []github.OrgMember{}
+		for _, member := range org.Members {
+			memberRole := member.Role
+			if memberRole == "" {
+				memberRole = "member"
+			}
+			if filter == github.MemberFilter2FADisabled && !member.TwoFactorDisabled {
+				continue
+			}
+			if role == "" || role == "all" || role == memberRole {
+				members = append(members, member.OrgMember)
+			}
+		}
+		writeList(w, r, s.state.PageSize, members)
+	case segments[0] == "hooks":
+		serveHooks(w, r, s.state.PageSize, org.Hooks, segments[1:])
+	case match(segments, "installations"):
+		writeWrappedList(w, r, s.state.PageSize, "installations", org.Installations)
+	case match(segments, "custom-repository-roles"):
+		if org.CustomRepoRoles == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		writeWrappedList(w, r, s.state.PageSize, "custom_roles", org.CustomRepoRoles)
+	case match(segments, "blocks"):
+		writeList(w, r, s.state.PageSize, append([]github.OrgMember{}, org.BlockedUsers...))
+	case match(segments, "interaction-limits"):
+		if org.InteractionLimit == nil {
+			// GitHub responds with an empty object if interactions are not limited.
+			writeJSON(w, http.StatusOK, object{})
+			return
+		}
+		writeJSON(w, http.StatusOK, org.InteractionLimit)
+	case match(segments, "invitations"):
+		writeList(w, r, s.state.PageSize, append([]github.Invitation{}, org.Invitations...))
+	case match(segments, "failed_invitations"):
+		writeList(w, r, s.state.PageSize, append([]github.Invitation{}, org.FailedInvitations...))
+	case segments[0] == "rulesets" && len(segments) <= 2:
+		serveRulesets(w, r, s.state.PageSize, org.Rulesets, segments[1:])
+	case match(segments, "teams"):
+		teams := make([]github.Team, len(org.Teams))
+		for i, team := range org.Teams {
+			teams[i] = team.Team
+		}
+		writeList(w, r, s.state.PageSize, teams)
+	case len(segments) == 3 && segments[0] == "teams":
+		serveTeam(w, r, s.state.PageSize, org, segments[1], segments[2])
+	case len(segments) >= 2 && segments[1] == "secrets":
+		serveSecrets(w, r, s.state.PageSize, org, github.SecretType(segments[0]), segments[2:])
+	case len(segments) >= 2 && segments[0] == "actions" && segments[1] == "variables":
+		serveVariables(w, r, s.state.PageSize, org, segments[2:])
+	case match(segments, "actions", "runner-groups"):
+		groups := make([]github.RunnerGroup, len(org.RunnerGroups))
+		for i, group := range org.RunnerGroups {
+			groups[i] = group.RunnerGroup
+		}
+		writeWrappedList(w, r, s.state.PageSize, "runner_groups", groups)
+	case len(segments) == 4 && match(segments[:2], "actions", "runner-groups") && segments[3] == "runners":
+		for _, group := range org.RunnerGroups {
+			if strconv.FormatInt(group.Id, 10) == segments[2] {
+				writeWrappedList(w, r, s.state.PageSize, "runners", group.Runners)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "Not Found")
+	case match(segments, "audit-log"):
+		if org.AuditLog == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		phrase := r.URL.Query().Get("phrase")
+		entries := []github.AuditLogEntry{}
+		for _, entry := range org.AuditLog {
+			if phrase == "" || phrase == "actor:"+entry.Actor {
+				entries = append(entries, entry)
+			}
+		}
+		writeList(w, r, s.state.PageSize, entries)
+	case match(segments, "outside_collaborators"):
+		filter := r.URL.Query().Get("filter")
+		collaborators := []github.OrgMember{}
+		for _, collaborator := range org.OutsideCollaborators {
+			if filter != github.MemberFilter2FADisabled || collaborator.TwoFactorDisabled {
+				collaborators = append(collaborators, collaborator.OrgMember)
+			}
+		}
+		writeList(w, r, s.state.PageSize, collaborators)
+	case match(segments, "repos"):
+		repos := make([]github.Repository, len(org.Repositories))
+		for i, repo := range org.Repositories {
+			repos[i] = repoResponse(org.Login, repo)
+		}
+		writeList(w, r, s.state.PageSize, repos)
+	case match(segments, "actions", "permissions"):
+		writeOptional(w, org.ActionsPermissions)
+	case match(segments, "actions", "permissions", "selected-actions"):
+		writeOptional(w, org.SelectedActions)
+	case match(segments, "actions", "permissions", "fork-pr-contributor-approval"):
+		writeOptional(w, org.ForkPRContributorApproval)
+	case match(segments, "actions", "permissions", "workflow"):
+		writeOptional(w, org.WorkflowPermissions)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	user := s.state.findUser(segments[0])
+	if user == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	segments = segments[1:]
+	switch {
+	case len(segments) == 0:
+		writeJSON(w, http.StatusOK, user.User)
+	case match(segments, "repos"):
+		writeRepoList(w, r, s.state.PageSize, user.Login, user.Repositories, true)
+	case match(segments, "events"):
+		writeList(w, r, s.state.PageSize, append([]github.Event{}, user.Events...))
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func writeRepoList(
+	w http.ResponseWriter,
+	r *http.Request,
+	pageSize int,
+	ownerLogin string,
+	repos []*Repository,
+	publicOnly bool,
+) {
+	result := []github.Repository{}
+	for _, repo := range repos {
+		response := repoResponse(ownerLogin, repo)
+		if publicOnly && response.Visibility != "public" {
+			continue
+		}
+		result = append(result, response)
+	}
+	writeList(w, r, pageSize, result)
+}
+
+func (s *Server) handleRepo(w http.ResponseWriter, r *http.Request, segments []string) {
+	owner := segments[0]
+	repo := s.state.findRepo(owner, segments[1])
+	if repo == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	segments = segments[2:]
+	switch {
+	case len(segments) == 0:
+		writeJSON(w, http.StatusOK, repoResponse(owner, repo))
+	case match(segments, "actions", "permissions"):
+		writeOptional(w, repo.ActionsPermissions)
+	case match(segments, "actions", "permissions", "selected-actions"):
+		writeOptional(w, repo.SelectedActions)
+	case match(segments, "actions", "permissions", "fork-pr-contributor-approval"):
+		writeOptional(w, repo.ForkPRContributorApproval)
+	case match(segments, "actions", "permissions", "workflow"):
+		writeOptional(w, repo.WorkflowPermissions)
+	case segments[0] == "hooks":
+		serveHooks(w, r, s.state.PageSize, repo.Hooks, segments[1:])
+	case match(segments, "keys"):
+		writeList(w, r, s.state.PageSize, append([]github.DeployKey{}, repo.DeployKeys...))
+	case match(segments, "collaborators"):
+		affiliation := r.URL.Query().Get("affiliation")
+		collaborators := []github.Collaborator{}
+		for _, collaborator := range repo.Collaborators {
+			if affiliation != github.AffiliationOutside || s.state.isOutsideCollaborator(owner, collaborator.Login) {
+				collaborators = append(collaborators, collaborator)
+			}
+		}
+		writeList(w, r, s.state.PageSize, collaborators)
+	case match(segments, "vulnerability-alerts"):
+		if repo.VulnerabilityAlerts {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			writeError(w, http.StatusNotFound, "Vulnerability alerts are disabled.")
+		}
+	case segments[0] == "contents":
+		serveContents(w, repo, strings.Join(segments[1:], "/"))
+	case len(segments) == 3 && segments[0] == "git" && segments[1] == "trees":
+		serveTree(w, r, repo)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func serveRulesets(w http.ResponseWriter, r *http.Request, pageSize int, rulesets []github.Ruleset, segments []string) {
+	if rulesets == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	if len(segments) == 0 {
+		// The list endpoint only returns a summary of each ruleset.
+		summaries := make([]github.Ruleset, len(rulesets))
+		for i, ruleset := range rulesets {
+			ruleset.Conditions = nil
+			ruleset.Rules = nil
+			summaries[i] = ruleset
+		}
+		writeList(w, r, pageSize, summaries)
+		return
+	}
+	for _, ruleset := range rulesets {
+		if strconv.FormatInt(ruleset.Id, 10) == segments[0] {
+			writeJSON(w, http.StatusOK, ruleset)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func serveTeam(w http.ResponseWriter, r *http.Request, pageSize int, org *Organization, slug string, resource string) {
+	for _, team := range org.Teams {
+		if team.Slug != slug {
+			continue
+		}
+		switch resource {
+		case "members":
+			role := r.URL.Query().Get("role")
+			members := []github.OrgMember{}
+			for _, member := range team.Members {
+				memberRole := member.Role
+				if memberRole == "" {
+					memberRole = github.TeamRoleMember
+				}
+				if role == "" || role == github.TeamRoleAll || role == memberRole {
+					members = append(members, member.OrgMember)
+				}
+			}
+			writeList(w, r, pageSize, members)
+		case "repos":
+			writeList(w, r, pageSize, append([]github.TeamRepository{}, team.Repositories...))
+		default:
+			writeError(w, http.StatusNotFound, "Not Found")
+		}
+		return
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func serveSecrets(
+	w http.ResponseWriter,
+	r *http.Request,
+	pageSize int,
+	org *Organization,
+	secretType github.SecretType,
+	segments []string,
+) {
+	if len(segments) == 0 {
+		secrets := []github.Secret{}
+		for _, secret := range org.Secrets {
+			if secret.Type == secretType {
+				secrets = append(secrets, secret.Secret)
+			}
+		}
+		writeWrappedList(w, r, pageSize, "secrets", secrets)
+		return
+	}
+	for _, secret := range org.Secrets {
+		if secret.Type != secretType || secret.Name != segments[0] {
+			continue
+		}
+		if !match(segments[1:], "repositories") || secret.Visibility != github.SecretVisibilitySelected {
+			break
+		}
+		repos := []github.Repository{}
+		for _, name := range secret.SelectedRepositories {
+			for _, repo := range org.Repositories {
+				if repo.Name == name {
+					repos = append(repos, repoResponse(org.Login, repo))
+				}
+			}
+		}
+		writeWrappedList(w, r, pageSize, "repositories", repos)
+		return
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func serveVariables(w http.ResponseWriter, r *http.Request, pageSize int, org *Organization, segments []string) {
+	if len(segments) == 0 {
+		variables := []github.Variable{}
+		for _, variable := range org.Variables {
+			variables = append(variables, variable.Variable)
+		}
+		writeWrappedList(w, r, pageSize, "variables", variables)
+		return
+	}
+	for _, variable := range org.Variables {
+		if variable.Name != segments[0] {
+			continue
+		}
+		if !match(segments[1:], "repositories") || variable.Visibility != github.SecretVisibilitySelected {
+			break
+		}
+		repos := []github.Repository{}
+		for _, name := range variable.SelectedRepositories {
+			for _, repo := range org.Repositories {
+				if repo.Name == name {
+					repos = append(repos, repoResponse(org.Login, repo))
+				}
+			}
+		}
+		writeWrappedList(w, r, pageSize, "repositories", repos)
+		return
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func serveHooks(w http.ResponseWriter, r *http.Request, pageSize int, hooks []Hook, segments []string) {
+	if len(segments) == 0 {
+		result := make([]github.Hook, len(hooks))
+		for i, hook := range hooks {
+			result[i] = hook.Hook
+		}
+		writeList(w, r, pageSize, result)
+		return
+	}
+	for _, hook := range hooks {
+		if strconv.FormatInt(hook.Id, 10) != segments[0] {
+			continue
+		}
+		switch {
+		case len(segments) == 1:
+			writeJSON(w, http.StatusOK, hook.Hook)
+		case match(segments[1:], "deliveries"):
+			writeList(w, r, pageSize, append([]github.HookDelivery{}, hook.Deliveries...))
+		default:
+			writeError(w, http.StatusNotFound, "Not Found")
+		}
+		return
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func repoResponse(ownerLogin string, repo *Repository) github.Repository {
+	result := repo.Repository
+	if result.FullName == "" {
+		result.FullName = ownerLogin + "/" + repo.Name
+	}
+	if result.DefaultBranch == "" {
+		result.DefaultBranch = "main"
+	}
+	if result.Visibility == "" {
+		result.Visibility = "public"
+	}
+	return result
+}
+
+func match(segments []string, expected ...string) bool {
+	if len(segments) != len(expected) {
+		return false
+	}
+	for i, segment := range segments {
+		if segment != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeOptional[T any](w http.ResponseWriter, data *T) {
+	if data == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(w, http.StatusOK, data)
+}
+
+// writeList writes one page of items and sets the Link header the same way GitHub does.
+func writeList[T any](w http.ResponseWriter, r *http.Request, defaultPageSize int, items []T) {
+	writeJSON(w, http.StatusOK, page(w, r, defaultPageSize, items))
+}
+
+// writeWrappedList writes one page of items wrapped in an object with the total count, for example
+// {"total_count": 1, "installations": [...]}.
+func writeWrappedList[T any](w http.ResponseWriter, r *http.Request, defaultPageSize int, field string, items []T) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"total_count": len(items),
+		field:         page(w, r, defaultPageSize, items),
+	})
+}
+
+// page returns the requested page of items and sets the Link header the same way GitHub does.
+func page[T any](w http.ResponseWriter, r *http.Request, defaultPageSize int, items []T) []T {
+	query := r.URL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = defaultPageSize
+	}
+	if perPage <= 0 {
+		perPage = 30
+	}
+	pageNumber, err := strconv.Atoi(query.Get("page"))
+	if err != nil || pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	start := (pageNumber - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	if end < len(items) {
+		query.Set("page", strconv.Itoa(pageNumber+1))
+		query.Set("per_page", strconv.Itoa(perPage))
+		next := url.URL{
+			Scheme:   "http",
+			Host:     r.Host,
+			Path:     r.URL.Path,
+			RawQuery: query.Encode(),
+		}
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.String()))
+	}
+	return append([]T{}, items[start:end]...)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]string{
+		"message":           message,
+		"documentation_url": "https://docs.github.com/rest",
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,264 @@
+package githubtest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func newClient(t *testing.T, state githubtest.State) (*githubtest.Server, github.Client) {
+	t.Helper()
+	srv := githubtest.New(state)
+	t.Cleanup(srv.Close)
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, c
+}
+
+func testOrg(repos ...*githubtest.Repository) *githubtest.Organization {
+	return &githubtest.Organization{
+		Organization: github.Organization{Login: "acme"},
+		Repositories: repos,
+	}
+}
+
+func countRequests(srv *githubtest.Server, request string) int {
+	count := 0
+	for _, r := range srv.Requests() {
+		if r == request {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPagination(t *testing.T) {
+	var repos []*githubtest.Repository
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		repos = append(repos, &githubtest.Repository{Repository: github.Repository{Name: name}})
+	}
+	srv, c := newClient(t, githubtest.State{
+		PageSize:      2,
+		Organizations: []*githubtest.Organization{testOrg(repos...)},
+	})
+
+	result, err := c.ListOrgRepositories("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, repo := range result {
+		names = append(names, repo.Name)
+	}
+	if strings.Join(names, ",") != "a,b,c,d,e" {
+		t.Fatalf("unexpected repositories: %v", names)
+	}
+	if n := countRequests(srv, "GET orgs/acme/repos"); n != 3 {
+		t.Fatalf("expected 3 page requests, got %d", n)
+	}
+}
+
+func TestToken(t *testing.T) {
+	_, c := newClient(t, githubtest.State{Organizations: []*githubtest.Organization{testOrg()}})
+	srv := githubtest.New(githubtest.State{
+		Token:         "secret",
+		Organizations: []*githubtest.Organization{testOrg()},
+	})
+	defer srv.Close()
+	wrongToken, err := github.NewClient(hublog.New(hublog.Error), "wrong", srv.URL, srv.HTTPClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetOrg("acme"); err != nil {
+		t.Fatalf("any token should be accepted if the state has none (%v)", err)
+	}
+	if _, err := wrongToken.GetOrg("acme"); err == nil {
+		t.Fatal("a wrong token was accepted")
+	}
+}
+
+func TestFaults(t *testing.T) {
+	srv, c := newClient(t, githubtest.State{Organizations: []*githubtest.Organization{
+		testOrg(&githubtest.Repository{Repository: github.Repository{Name: "app"}}),
+	}})
+
+	if err := srv.Inject("repos/acme/*/keys", githubtest.Fault{StatusCode: http.StatusNotFound, Times: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListRepoDeployKeys("acme", "app"); !github.IsNotFound(err) {
+		t.Fatalf("expected a 404, got %v", err)
+	}
+	if _, err := c.ListRepoDeployKeys("acme", "app"); err != nil {
+		t.Fatalf("the fault should only apply once (%v)", err)
+	}
+
+	if err := srv.Inject("orgs/acme/hooks", githubtest.Fault{RateLimited: true}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := c.ListOrgHooks("acme")
+		var apiErr *github.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden || apiErr.RateLimitRemaining != "0" {
+			t.Fatalf("expected a rate limit error, got %v", err)
+		}
+		if !github.IsRateLimited(err) || github.IsForbidden(err) {
+			t.Fatalf("a rate limit error was not told apart from a missing permission (%v)", err)
+		}
+	}
+	srv.ClearFaults()
+	if _, err := c.ListOrgHooks("acme"); err != nil {
+		t.Fatalf("faults were not cleared (%v)", err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	srv, c := newClient(t, githubtest.State{Organizations: []*githubtest.Organization{testOrg()}})
+	srv.Update(func(state *githubtest.State) {
+		state.Organizations[0].BlockedUsers = []github.OrgMember{{Login: "spammer"}}
+	})
+
+	blocked, err := c.ListOrgBlockedUsers("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocked) != 1 || blocked[0].Login != "spammer" {
+		t.Fatalf("unexpected blocked users: %v", blocked)
+	}
+}
+
+func TestContents(t *testing.T) {
+	srv, c := newClient(t, githubtest.State{Organizations: []*githubtest.Organization{
+		testOrg(&githubtest.Repository{
+			Repository: github.Repository{Name: "app", DefaultBranch: "main"},
+			Files: map[string]string{
+				"README.md":                   "# App",
+				".github/workflows/build.yml": "runs-on: ubuntu-latest",
+			},
+		}),
+	}})
+
+	entries, err := c.ListContents("acme", "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := map[string]github.FileType{}
+	for _, entry := range entries {
+		paths[entry.Path] = entry.Type
+	}
+	expected := map[string]github.FileType{
+		"README.md":                   github.FileTypeFile,
+		".github":                     github.FileTypeDir,
+		".github/workflows":           github.FileTypeDir,
+		".github/workflows/build.yml": github.FileTypeFile,
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("unexpected entries: %v", paths)
+	}
+	for entryPath, fileType := range expected {
+		if paths[entryPath] != fileType {
+			t.Fatalf("expected %s to be a %s, got %v", entryPath, fileType, paths)
+		}
+	}
+
+	content, err := c.GetContents("acme", "app", ".github/workflows/build.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "runs-on: ubuntu-latest" {
+		t.Fatalf("unexpected content: %s", content)
+	}
+	if _, err := c.GetContents("acme", "app", "missing.txt"); !github.IsNotFound(err) {
+		t.Fatalf("expected a 404 for a missing file, got %v", err)
+	}
+
+	response, err := srv.HTTPClient().Get(srv.URL + "repos/acme/app/git/trees/main?recursive=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	var tree struct {
+		Tree []struct {
+			Path string `json:"path"`
+			Type string `json:"type"`
+		} `json:"tree"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&tree); err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Tree) != len(expected) {
+		t.Fatalf("unexpected tree: %v", tree.Tree)
+	}
+}
+
+func TestGraphQLMetadata(t *testing.T) {
+	_, c := newClient(t, githubtest.State{Organizations: []*githubtest.Organization{
+		testOrg(&githubtest.Repository{
+			Repository: github.Repository{Name: "app", DefaultBranch: "main"},
+			Files: map[string]string{
+				"SECURITY.md":                     "Report issues to security@example.com",
+				".github/ISSUE_TEMPLATE/bug.md":   "---",
+				".github/workflows/build.yml":     "runs-on: ubuntu-latest",
+				"docs/CODE_OF_CONDUCT.md":         "Be nice",
+				"src/main.go":                     "package main",
+				"src/internal/unrelated/file.txt": "",
+			},
+		}),
+	}})
+
+	metadata, err := c.GetRepoMetadata("acme", "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.SecurityPolicy() == nil {
+		t.Fatal("the security policy was not found")
+	}
+	if metadata.CodeOfConduct() == nil {
+		t.Fatal("the code of conduct was not found")
+	}
+	if !metadata.HasIssueTemplates() {
+		t.Fatal("the issue templates were not found")
+	}
+	if metadata.ContributingGuide() != nil {
+		t.Fatal("a contributing guide was found in a repository without one")
+	}
+	if len(metadata.Workflows()) != 1 {
+		t.Fatalf("unexpected workflows: %v", metadata.Workflows())
+	}
+}
+
+func TestAuthenticatedUserRepositories(t *testing.T) {
+	_, c := newClient(t, githubtest.State{
+		Users: []*githubtest.User{
+			{
+				User: github.User{Login: "octocat", Type: "User"},
+				Repositories: []*githubtest.Repository{
+					{Repository: github.Repository{Name: "public", Visibility: "public"}},
+					{Repository: github.Repository{Name: "private", Visibility: "private"}},
+				},
+			},
+		},
+		AuthenticatedUser: "octocat",
+	})
+
+	// Logins are case-insensitive, the private repositories must be listed regardless of the spelling.
+	for _, login := range []string{"octocat", "OctoCat"} {
+		repos, err := c.ListUserRepositories(login)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(repos) != 2 {
+			t.Fatalf("expected 2 repositories for %s, got %d", login, len(repos))
+		}
+	}
+}
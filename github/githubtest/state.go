@@ -0,0 +1,252 @@
+package githubtest
+
+import (
+	"strings"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+// State describes the data the fake GitHub API serves. It can be passed to New and later changed using
+// Server.Update.
+type State struct {
+	// Token is the access token the server expects. If it is empty, any token is accepted.
+	Token string
+	// PageSize is the default number of items returned per page on list endpoints. Defaults to 30.
+	PageSize int
+	// Organizations contains the organizations the authenticated user is a member of.
+	Organizations []*Organization
+	// Enterprises contains the enterprises served by the GraphQL API.
+	Enterprises []*Enterprise
+	// Users contains the user accounts served by the fake API.
+	Users []*User
+	// AuthenticatedUser is the login of the user in Users the token belongs to. If it is empty, a user named
+	// "githubtest" without repositories is used.
+	AuthenticatedUser string
+}
+
+// User is a user account served by the fake API.
+type User struct {
+	github.User
+
+	// Repositories lists the repositories owned by the user.
+	Repositories []*Repository
+	// Events lists the public events of the user, latest first.
+	Events []github.Event
+}
+
+// Enterprise is an enterprise account served by the fake API.
+type Enterprise struct {
+	Slug string
+	// Organizations lists the logins of the organizations in the enterprise.
+	Organizations []string
+}
+
+// Organization is an organization served by the fake API.
+type Organization struct {
+	github.Organization
+
+	// Members lists the members of the organization.
+	Members []Member
+	// OutsideCollaborators lists the outside collaborators of the organization. The Role field is ignored. Add them to
+	// the Collaborators of the repositories they have access to.
+	OutsideCollaborators []Member
+	// ActionsPermissions is returned from the organization actions permissions endpoint. If it is nil, the endpoint
+	// responds with a 404.
+	ActionsPermissions *github.ActionsPermissions
+	// SelectedActions is returned from the organization selected actions endpoint. If it is nil, the endpoint responds
+	// with a 404.
+	SelectedActions *github.SelectedActions
+	// ForkPRContributorApproval is returned from the organization fork pull request contributor approval endpoint. If
+	// it is nil, the endpoint responds with a 404.
+	ForkPRContributorApproval *github.ForkPRContributorApproval
+	// WorkflowPermissions is returned from the organization workflow permissions endpoint. If it is nil, the endpoint
+	// responds with a 404.
+	WorkflowPermissions *github.WorkflowPermissions
+	// Hooks lists the webhooks of the organization.
+	Hooks []Hook
+	// Domains lists the verified and approved domains of the organization, served by the GraphQL API.
+	Domains []github.OrgDomain
+	// NotificationsRestricted indicates that email notifications are restricted to the Domains.
+	NotificationsRestricted bool
+	// Installations lists the GitHub Apps installed on the organization.
+	Installations []github.Installation
+	// RunnerGroups lists the self-hosted runner groups of the organization.
+	RunnerGroups []RunnerGroup
+	// CustomRepoRoles lists the custom repository roles of the organization. If it is nil, the endpoint responds with
+	// a 404, the same as for organizations without GitHub Enterprise.
+	CustomRepoRoles []github.CustomRepoRole
+	// BlockedUsers lists the users blocked by the organization.
+	BlockedUsers []github.OrgMember
+	// InteractionLimit is the active interaction limit of the organization, if any.
+	InteractionLimit *github.InteractionLimit
+	// Invitations lists the pending invitations of the organization.
+	Invitations []github.Invitation
+	// FailedInvitations lists the failed invitations of the organization.
+	FailedInvitations []github.Invitation
+	// Rulesets lists the rulesets of the organization. If it is nil, the rulesets endpoints respond with a 404.
+	Rulesets []github.Ruleset
+	// Teams lists the teams of the organization.
+	Teams []Team
+	// Secrets lists the organization secrets of all features.
+	Secrets []Secret
+	// Variables lists the organization GitHub Actions variables.
+	Variables []Variable
+	// AuditLog contains the audit log entries of the organization, latest first. If it is nil, the audit log endpoint
+	// responds with a 404, the same as for organizations without GitHub Enterprise.
+	AuditLog []github.AuditLogEntry
+	// Repositories lists the repositories owned by the organization.
+	Repositories []*Repository
+}
+
+// RunnerGroup is a self-hosted runner group with its runners.
+type RunnerGroup struct {
+	github.RunnerGroup
+
+	// Runners lists the runners in the group.
+	Runners []github.Runner
+}
+
+// Team is a team with its members and repositories.
+type Team struct {
+	github.Team
+
+	// Members lists the members of the team. The Role field is either github.TeamRoleMaintainer or
+	// github.TeamRoleMember, and defaults to the latter.
+	Members []Member
+	// Repositories lists the repositories the team has access to.
+	Repositories []github.TeamRepository
+}
+
+// Secret is an organization secret.
+type Secret struct {
+	github.Secret
+
+	// Type is the feature the secret belongs to.
+	Type github.SecretType
+	// SelectedRepositories lists the names of the repositories selected for the secret.
+	SelectedRepositories []string
+}
+
+// Variable is an organization GitHub Actions variable.
+type Variable struct {
+	github.Variable
+
+	// SelectedRepositories lists the names of the repositories selected for the variable.
+	SelectedRepositories []string
+}
+
+// Hook is a webhook with its deliveries.
+type Hook struct {
+	github.Hook
+
+	// Deliveries lists the deliveries of the webhook, latest first.
+	Deliveries []github.HookDelivery
+}
+
+// Member is an organization member with its role.
+type Member struct {
+	github.OrgMember
+
+	// Role is either "admin" or "member". Defaults to "member".
+	Role string
+	// TwoFactorDisabled indicates that the user has not enabled two-factor authentication.
+	TwoFactorDisabled bool
+}
+
+// Repository is a repository served by the fake API.
+type Repository struct {
+	github.Repository
+
+	// ActionsPermissions is returned from the repository actions permissions endpoint. If it is nil, the endpoint
+	// responds with a 404.
+	ActionsPermissions *github.ActionsPermissions
+	// SelectedActions is returned from the repository selected actions endpoint. If it is nil, the endpoint responds
+	// with a 404.
+	SelectedActions *github.SelectedActions
+	// ForkPRContributorApproval is returned from the repository fork pull request contributor approval endpoint. If it
+	// is nil, the endpoint responds with a 404.
+	ForkPRContributorApproval *github.ForkPRContributorApproval
+	// WorkflowPermissions is returned from the repository workflow permissions endpoint. If it is nil, the endpoint
+	// responds with a 404.
+	WorkflowPermissions *github.WorkflowPermissions
+	// Hooks lists the webhooks of the repository.
+	Hooks []Hook
+	// DeployKeys lists the deploy keys of the repository.
+	DeployKeys []github.DeployKey
+	// Collaborators lists the users with direct access to the repository. Collaborators who are listed in the
+	// OutsideCollaborators of the organization are returned for the outside affiliation.
+	Collaborators []github.Collaborator
+	// VulnerabilityAlerts indicates if Dependabot alerts are enabled.
+	VulnerabilityAlerts bool
+	// Files maps file paths on the default branch to their contents. Directories are derived from the paths.
+	Files map[string]string
+	// BranchProtection is the branch protection rule of the default branch, if any.
+	BranchProtection *github.BranchProtection
+}
+
+// findOrg returns the organization with the login. Like on GitHub, logins are case-insensitive.
+func (s State) findOrg(login string) *Organization {
+	for _, org := range s.Organizations {
+		if strings.EqualFold(org.Login, login) {
+			return org
+		}
+	}
+	return nil
+}
+
+// isOutsideCollaborator returns true if the login is an outside collaborator of the owner. All collaborators of user
+// repositories are outside collaborators.
+func (s State) isOutsideCollaborator(owner string, login string) bool {
+	org := s.findOrg(owner)
+	if org == nil {
+		return true
+	}
+	for _, collaborator := range org.OutsideCollaborators {
+		if strings.EqualFold(collaborator.Login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s State) findUser(login string) *User {
+	for _, user := range s.Users {
+		if strings.EqualFold(user.Login, login) {
+			return user
+		}
+	}
+	return nil
+}
+
+func (s State) authenticatedUser() *User {
+	if user := s.findUser(s.AuthenticatedUser); user != nil {
+		return user
+	}
+	return &User{
+		User: github.User{
+			Login: "githubtest",
+			Type:  "User",
+		},
+	}
+}
+
+// findOwnerRepos returns the repositories of an organization or user, and false if the owner does not exist.
+func (s State) findOwnerRepos(login string) ([]*Repository, bool) {
+	if org := s.findOrg(login); org != nil {
+		return org.Repositories, true
+	}
+	if user := s.findUser(login); user != nil {
+		return user.Repositories, true
+	}
+	return nil, false
+}
+
+func (s State) findRepo(owner string, name string) *Repository {
+	repos, _ := s.findOwnerRepos(owner)
+	for _, repo := range repos {
+		if repo.Name == name {
+			return repo
+		}
+	}
+	return nil
+}
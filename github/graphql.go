@@ -0,0 +1,77 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// graphQLURL derives the GraphQL endpoint from the REST API base URL. GitHub Enterprise Server serves the REST API
+// under /api/v3/ and the GraphQL API under /api/graphql.
+func graphQLURL(baseURL string) string {
+	if strings.HasSuffix(baseURL, "/api/v3/") {
+		return strings.TrimSuffix(baseURL, "v3/") + "graphql"
+	}
+	return baseURL + "graphql"
+}
+
+type graphQLRequestBody struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Type    string        `json:"type"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
+}
+
+type graphQLResponse[T any] struct {
+	Data   *T             `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// graphQLRequest sends a GraphQL query and decodes the data part of the response into responseObject. Errors
+// affecting only parts of the response, such as fields the token has no access to, are logged and the partial data
+// is returned.
+//
+// This is a non-receiver method due to https://github.com/golang/go/issues/49085
+func graphQLRequest[T any](c *client, query string, variables map[string]interface{}, responseObject *T) error {
+	requestBody, err := json.Marshal(graphQLRequestBody{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode GraphQL request (%w)", err)
+	}
+	status, _, body, err := c.requestWithBody("POST", c.graphQLURL, requestBody)
+	if err != nil {
+		return err
+	}
+	if status != 200 {
+		return fmt.Errorf("unexpected HTTP response code: %d (%s)", status, body)
+	}
+
+	response := graphQLResponse[T]{
+		Data: responseObject,
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("failed to decode GitHub GraphQL response (%v; %s)", err, body)
+	}
+	if len(response.Errors) > 0 {
+		var messages []string
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		if response.Data == nil {
+			return fmt.Errorf("GraphQL query failed (%s)", strings.Join(messages, "; "))
+		}
+		c.logger.WithLevel(hublog.Warning).Logf(
+			"GraphQL query returned partial data (%s)",
+			strings.Join(messages, "; "),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package github
+
+import "time"
+
+// recentHookDeliveries is the number of deliveries fetched by ListRecentOrgHookDeliveries and
+// ListRecentRepoHookDeliveries.
+const recentHookDeliveries = 10
+
+// Hook is an organization or repository webhook.
+type Hook struct {
+	Id     int64    `json:"id"`
+	Name   string   `json:"name"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+	Config struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type"`
+		// InsecureSSL is "1" if TLS certificate verification is disabled.
+		InsecureSSL string `json:"insecure_ssl"`
+		// Secret is masked by the API, it is only non-empty if a secret is set.
+		Secret string `json:"secret,omitempty"`
+	} `json:"config"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HookDelivery is a delivery attempt of a webhook.
+type HookDelivery struct {
+	Id          int64     `json:"id"`
+	Guid        string    `json:"guid"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	Redelivery  bool      `json:"redelivery"`
+	Status      string    `json:"status"`
+	StatusCode  int       `json:"status_code"`
+	Event       string    `json:"event"`
+}
+
+// Failed returns true if the receiver did not accept the delivery.
+func (d HookDelivery) Failed() bool {
+	return d.StatusCode < 200 || d.StatusCode >= 300
+}
@@ -0,0 +1,19 @@
+package github
+
+import "time"
+
+// Installation is a GitHub App installed on an organization.
+type Installation struct {
+	Id      int64  `json:"id"`
+	AppId   int64  `json:"app_id"`
+	AppSlug string `json:"app_slug"`
+	// RepositorySelection is either "all" or "selected".
+	RepositorySelection string `json:"repository_selection"`
+	// Permissions maps permission names, such as "contents", to the access level, "read", "write" or "admin".
+	Permissions map[string]string `json:"permissions"`
+	Events      []string          `json:"events"`
+	HtmlUrl     string            `json:"html_url"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	SuspendedAt *time.Time        `json:"suspended_at"`
+}
@@ -0,0 +1,32 @@
+package github
+
+import "time"
+
+// InvitationRoleAdmin is the role of an invitation to become an owner of the organization.
+const InvitationRoleAdmin = "admin"
+
+// Invitation is an invitation to join an organization.
+type Invitation struct {
+	Id int64 `json:"id"`
+	// Login is the invited user. It is empty if the invitation was sent to an email address.
+	Login string `json:"login"`
+	Email string `json:"email"`
+	// Role is "direct_member", "admin", "billing_manager", "hiring_manager" or "reinstate".
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	// FailedAt is the time the invitation failed, if it did.
+	FailedAt     *time.Time `json:"failed_at"`
+	FailedReason string     `json:"failed_reason"`
+	Inviter      struct {
+		Login string `json:"login"`
+	} `json:"inviter"`
+	TeamCount int `json:"team_count"`
+}
+
+// Invitee returns the login of the invited user or the email address the invitation was sent to.
+func (i Invitation) Invitee() string {
+	if i.Login != "" {
+		return i.Login
+	}
+	return i.Email
+}
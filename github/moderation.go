@@ -0,0 +1,17 @@
+package github
+
+import "time"
+
+// InteractionLimit temporarily restricts who can comment, open issues and create pull requests in the public
+// repositories of an organization.
+type InteractionLimit struct {
+	// Limit is "existing_users", "contributors_only" or "collaborators_only". It is empty if no limit is active.
+	Limit     string    `json:"limit"`
+	Origin    string    `json:"origin"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Active returns true if interactions are limited.
+func (l InteractionLimit) Active() bool {
+	return l.Limit != ""
+}
@@ -55,17 +55,159 @@ type Organization struct {
 	MembersCanCreatePrivateRepositories  bool   `json:"members_can_create_private_repositories"`
 	MembersCanCreateInternalRepositories bool   `json:"members_can_create_internal_repositories"`
 	MembersCanCreatePages                bool   `json:"members_can_create_pages"`
+	MembersCanCreatePublicPages          bool   `json:"members_can_create_public_pages"`
 	MembersCanForkPrivateRepositories    bool   `json:"members_can_fork_private_repositories"`
+	// WebCommitSignoffRequired is only returned to organization admins.
+	WebCommitSignoffRequired *bool `json:"web_commit_signoff_required"`
+	// The security feature defaults for new repositories are only returned to organization admins.
+	DependabotAlertsEnabledForNewRepositories             *bool `json:"dependabot_alerts_enabled_for_new_repositories"`
+	DependabotSecurityUpdatesEnabledForNewRepositories    *bool `json:"dependabot_security_updates_enabled_for_new_repositories"`
+	DependencyGraphEnabledForNewRepositories              *bool `json:"dependency_graph_enabled_for_new_repositories"`
+	SecretScanningEnabledForNewRepositories               *bool `json:"secret_scanning_enabled_for_new_repositories"`
+	SecretScanningPushProtectionEnabledForNewRepositories *bool `json:"secret_scanning_push_protection_enabled_for_new_repositories"`
+}
+
+// NewOrganization binds the organization data to a client. This is useful for constructing test data, for example
+// using a githubmock.Client.
+func NewOrganization(c Client, org Organization) *Organization {
+	org.client = c
+	return &org
+}
+
+func (o Organization) GetLogin() string {
+	return o.Login
+}
+
+func (o Organization) OwnerType() OwnerType {
+	return OwnerTypeOrganization
 }
 
 func (o Organization) GetActionsPermissions() (*ActionsPermissions, error) {
 	return o.client.GetGitHubActionsOrgPermissions(o.Login)
 }
 
+func (o Organization) GetSelectedActions() (*SelectedActions, error) {
+	return o.client.GetGitHubActionsOrgSelectedActions(o.Login)
+}
+
+func (o Organization) GetForkPRContributorApproval() (*ForkPRContributorApproval, error) {
+	return o.client.GetGitHubActionsOrgForkPRContributorApproval(o.Login)
+}
+
+func (o Organization) GetWorkflowPermissions() (*WorkflowPermissions, error) {
+	return o.client.GetGitHubActionsOrgWorkflowPermissions(o.Login)
+}
+
 func (o Organization) ListAdmins() ([]*OrgMember, error) {
 	return o.client.ListOrgAdmins(o.Login)
 }
 
+// GetLastAuditLogEntry returns the latest audit log entry caused by the actor, or nil if there is none.
+func (o Organization) GetLastAuditLogEntry(actor string) (*AuditLogEntry, error) {
+	return o.client.GetLastAuditLogEntry(o.Login, actor)
+}
+
+// GetDomainSettings returns the verified and approved domains of the organization.
+func (o Organization) GetDomainSettings() (*OrgDomainSettings, error) {
+	return o.client.GetOrgDomainSettings(o.Login)
+}
+
+func (o Organization) ListHooks() ([]*Hook, error) {
+	return o.client.ListOrgHooks(o.Login)
+}
+
+func (o Organization) ListInstallations() ([]*Installation, error) {
+	return o.client.ListOrgInstallations(o.Login)
+}
+
+func (o Organization) ListCustomRepoRoles() ([]*CustomRepoRole, error) {
+	return o.client.ListOrgCustomRepoRoles(o.Login)
+}
+
+func (o Organization) ListBlockedUsers() ([]*OrgMember, error) {
+	return o.client.ListOrgBlockedUsers(o.Login)
+}
+
+// GetInteractionLimits returns the interaction limits of the organization. The limit is empty if interactions are not
+// limited.
+func (o Organization) GetInteractionLimits() (*InteractionLimit, error) {
+	return o.client.GetOrgInteractionLimits(o.Login)
+}
+
+func (o Organization) ListInvitations() ([]*Invitation, error) {
+	return o.client.ListOrgInvitations(o.Login)
+}
+
+func (o Organization) ListFailedInvitations() ([]*Invitation, error) {
+	return o.client.ListOrgFailedInvitations(o.Login)
+}
+
+// ListRulesets lists the rulesets of the organization. The conditions and rules are only returned by GetRuleset.
+func (o Organization) ListRulesets() ([]*Ruleset, error) {
+	return o.client.ListOrgRulesets(o.Login)
+}
+
+func (o Organization) GetRuleset(rulesetID int64) (*Ruleset, error) {
+	return o.client.GetOrgRuleset(o.Login, rulesetID)
+}
+
+func (o Organization) ListRunnerGroups() ([]*RunnerGroup, error) {
+	return o.client.ListOrgRunnerGroups(o.Login)
+}
+
+func (o Organization) ListTeams() ([]*Team, error) {
+	return o.client.ListOrgTeams(o.Login)
+}
+
+// ListTeamMembers lists the members of a team with the specified role, for example TeamRoleMaintainer.
+func (o Organization) ListTeamMembers(teamSlug string, role string) ([]*OrgMember, error) {
+	return o.client.ListTeamMembers(o.Login, teamSlug, role)
+}
+
+func (o Organization) ListTeamRepositories(teamSlug string) ([]*TeamRepository, error) {
+	return o.client.ListTeamRepositories(o.Login, teamSlug)
+}
+
+// ListSecrets lists the metadata of the organization secrets of a feature.
+func (o Organization) ListSecrets(secretType SecretType) ([]*Secret, error) {
+	return o.client.ListOrgSecrets(o.Login, secretType)
+}
+
+// ListSecretRepositories lists the repositories selected for an organization secret.
+func (o Organization) ListSecretRepositories(secretType SecretType, name string) ([]*Repository, error) {
+	return o.client.ListOrgSecretRepositories(o.Login, secretType, name)
+}
+
+// ListVariables lists the metadata of the organization GitHub Actions variables.
+func (o Organization) ListVariables() ([]*Variable, error) {
+	return o.client.ListOrgVariables(o.Login)
+}
+
+// ListVariableRepositories lists the repositories selected for an organization variable.
+func (o Organization) ListVariableRepositories(name string) ([]*Repository, error) {
+	return o.client.ListOrgVariableRepositories(o.Login, name)
+}
+
+func (o Organization) ListRunnerGroupRunners(groupID int64) ([]*Runner, error) {
+	return o.client.ListOrgRunnerGroupRunners(o.Login, groupID)
+}
+
+// ListRecentHookDeliveries returns the latest deliveries of an organization webhook, latest first.
+func (o Organization) ListRecentHookDeliveries(hookID int64) ([]*HookDelivery, error) {
+	return o.client.ListRecentOrgHookDeliveries(o.Login, hookID)
+}
+
+// ListMembers lists the members of the organization. The filter is either MemberFilterAll or MemberFilter2FADisabled.
+func (o Organization) ListMembers(filter string) ([]*OrgMember, error) {
+	return o.client.ListOrgMembers(o.Login, filter)
+}
+
+// ListOutsideCollaborators lists the outside collaborators of the organization. The filter is either MemberFilterAll or
+// MemberFilter2FADisabled.
+func (o Organization) ListOutsideCollaborators(filter string) ([]*OrgMember, error) {
+	return o.client.ListOrgOutsideCollaborators(o.Login, filter)
+}
+
 func (o Organization) ListRepositories() ([]*Repository, error) {
 	return o.client.ListOrgRepositories(o.Login)
 }
@@ -22,3 +22,15 @@ type OrgMember struct {
 	Type              string `json:"type"`
 	SiteAdmin         bool   `json:"site_admin"`
 }
+
+// NewOrgMember binds the member data to a client. This is useful for constructing test data, for example using a
+// githubmock.Client.
+func NewOrgMember(c Client, member OrgMember) *OrgMember {
+	member.client = c
+	return &member
+}
+
+// GetLastEvent returns the latest public event of the member, or nil if there are no recent public events.
+func (m OrgMember) GetLastEvent() (*Event, error) {
+	return m.client.GetLastUserEvent(m.Login)
+}
@@ -0,0 +1,19 @@
+package github
+
+// OwnerType describes the kind of account owning repositories.
+type OwnerType string
+
+const (
+	OwnerTypeOrganization OwnerType = "Organization"
+	OwnerTypeUser         OwnerType = "User"
+)
+
+// Owner is an account that owns repositories. Both Organization and User implement this interface.
+type Owner interface {
+	// GetLogin returns the login name of the account.
+	GetLogin() string
+	// OwnerType returns the kind of the account.
+	OwnerType() OwnerType
+	// ListRepositories lists the repositories owned by the account.
+	ListRepositories() ([]*Repository, error)
+}
@@ -7,6 +7,7 @@ type Repository struct {
 	client   Client `json:"-"`
 	orgLogin string `json:"-"`
 
+	Id              int64        `json:"id"`
 	Name            string       `json:"name"`
 	FullName        string       `json:"full_name"`
 	Description     string       `json:"description"`
@@ -42,14 +43,63 @@ type RepoLicense struct {
 	SPDX string `json:"spdx_id"`
 }
 
+// NewRepository binds the repository data of the specified owner to a client. This is useful for constructing test
+// data, for example using a githubmock.Client.
+func NewRepository(c Client, ownerLogin string, repo Repository) *Repository {
+	repo.client = c
+	repo.orgLogin = ownerLogin
+	return &repo
+}
+
 func (r Repository) GetActionsPermissions() (*ActionsPermissions, error) {
 	return r.client.GetGitHubActionsRepoPermissions(r.orgLogin, r.Name)
 }
 
+func (r Repository) GetSelectedActions() (*SelectedActions, error) {
+	return r.client.GetGitHubActionsRepoSelectedActions(r.orgLogin, r.Name)
+}
+
+func (r Repository) GetForkPRContributorApproval() (*ForkPRContributorApproval, error) {
+	return r.client.GetGitHubActionsRepoForkPRContributorApproval(r.orgLogin, r.Name)
+}
+
+func (r Repository) GetWorkflowPermissions() (*WorkflowPermissions, error) {
+	return r.client.GetGitHubActionsRepoWorkflowPermissions(r.orgLogin, r.Name)
+}
+
+// ListCollaborators lists the collaborators with the specified affiliation, for example AffiliationOutside.
+func (r Repository) ListCollaborators(affiliation string) ([]*Collaborator, error) {
+	return r.client.ListRepoCollaborators(r.orgLogin, r.Name, affiliation)
+}
+
+func (r Repository) ListDeployKeys() ([]*DeployKey, error) {
+	return r.client.ListRepoDeployKeys(r.orgLogin, r.Name)
+}
+
+func (r Repository) ListHooks() ([]*Hook, error) {
+	return r.client.ListRepoHooks(r.orgLogin, r.Name)
+}
+
+// ListRecentHookDeliveries returns the latest deliveries of a repository webhook, latest first.
+func (r Repository) ListRecentHookDeliveries(hookID int64) ([]*HookDelivery, error) {
+	return r.client.ListRecentRepoHookDeliveries(r.orgLogin, r.Name, hookID)
+}
+
 func (r Repository) VulnerabilityAlertsEnabled() (bool, error) {
 	return r.client.RepoVulnerabilityAlertsEnabled(r.orgLogin, r.Name)
 }
 
+// GetContents returns the contents of a file on the default branch.
+func (r Repository) GetContents(path string) ([]byte, error) {
+	return r.client.GetContents(r.orgLogin, r.Name, path)
+}
+
 func (r Repository) ListContents() ([]RepoDirEntry, error) {
 	return r.client.ListContents(r.orgLogin, r.Name)
 }
+
+// GetMetadata returns the repository metadata. The metadata of all repositories of the owner is fetched in bulk on
+// the first call and cached afterwards.
+func (r Repository) GetMetadata() (*RepoMetadata, error) {
+	return r.client.GetRepoMetadata(r.orgLogin, r.Name)
+}
@@ -0,0 +1,374 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// repoMetadataPageSize is the number of repositories fetched in a single GraphQL query. Each repository includes
+// four tree listings, so larger pages run into the GraphQL resource limits.
+const repoMetadataPageSize = 50
+
+// RepoMetadata is a snapshot of the repository settings and files fetched in bulk using the GraphQL API.
+type RepoMetadata struct {
+	Name          string       `json:"name"`
+	Visibility    string       `json:"visibility"`
+	Topics        []string     `json:"topics"`
+	License       *RepoLicense `json:"license,omitempty"`
+	DefaultBranch string       `json:"default_branch"`
+	// DefaultBranchProtection is nil if the default branch has no branch protection rule, or if the token is not
+	// allowed to read it.
+	DefaultBranchProtection *BranchProtection `json:"default_branch_protection,omitempty"`
+	// Files contains the entries of the root, .github, .github/workflows and docs directories on the default branch.
+	Files []RepoFile `json:"files"`
+}
+
+// BranchProtection describes a branch protection rule.
+type BranchProtection struct {
+	Pattern                      string `json:"pattern"`
+	RequiresApprovingReviews     bool   `json:"requires_approving_reviews"`
+	RequiredApprovingReviewCount int    `json:"required_approving_review_count"`
+	RequiresCodeOwnerReviews     bool   `json:"requires_code_owner_reviews"`
+	DismissesStaleReviews        bool   `json:"dismisses_stale_reviews"`
+	RequiresStatusChecks         bool   `json:"requires_status_checks"`
+	RequiresStrictStatusChecks   bool   `json:"requires_strict_status_checks"`
+	RequiresCommitSignatures     bool   `json:"requires_commit_signatures"`
+	RequiresLinearHistory        bool   `json:"requires_linear_history"`
+	IsAdminEnforced              bool   `json:"is_admin_enforced"`
+	AllowsForcePushes            bool   `json:"allows_force_pushes"`
+	AllowsDeletions              bool   `json:"allows_deletions"`
+}
+
+// RepoFile is a file or directory entry in the repository metadata.
+type RepoFile struct {
+	Type FileType `json:"type"`
+	Name string   `json:"name"`
+	Path string   `json:"path"`
+	Size int      `json:"size"`
+}
+
+// FindFile returns the first file in the listed directories whose name matches. The root directory is "". Returns
+// nil if no such file exists.
+func (m RepoMetadata) FindFile(directories []string, match func(name string) bool) *RepoFile {
+	for _, dir := range directories {
+		for i, f := range m.Files {
+			if f.Type != FileTypeFile || f.Path != strings.TrimPrefix(dir+"/"+f.Name, "/") {
+				continue
+			}
+			if match(f.Name) {
+				return &m.Files[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Readme returns the README file GitHub displays for the repository, or nil if there is none.
+func (m RepoMetadata) Readme() *RepoFile {
+	return m.FindFile([]string{".github", "", "docs"}, func(name string) bool {
+		return strings.HasPrefix(strings.ToUpper(name), "README")
+	})
+}
+
+// SecurityPolicy returns the SECURITY file of the repository, or nil if there is none.
+func (m RepoMetadata) SecurityPolicy() *RepoFile {
+	return m.FindFile([]string{"", ".github", "docs"}, func(name string) bool {
+		return strings.HasPrefix(strings.ToUpper(name), "SECURITY")
+	})
+}
+
+// CodeOfConduct returns the CODE_OF_CONDUCT file of the repository, or nil if there is none.
+func (m RepoMetadata) CodeOfConduct() *RepoFile {
+	return m.FindFile([]string{"", ".github", "docs"}, func(name string) bool {
+		return strings.HasPrefix(strings.ToUpper(name), "CODE_OF_CONDUCT")
+	})
+}
+
+// ContributingGuide returns the CONTRIBUTING file of the repository, or nil if there is none.
+func (m RepoMetadata) ContributingGuide() *RepoFile {
+	return m.FindFile([]string{".github", "", "docs"}, func(name string) bool {
+		return strings.HasPrefix(strings.ToUpper(name), "CONTRIBUTING")
+	})
+}
+
+// HasIssueTemplates returns true if the repository has an ISSUE_TEMPLATE directory or a single issue template file.
+func (m RepoMetadata) HasIssueTemplates() bool {
+	for _, f := range m.Files {
+		if f.Type == FileTypeDir && strings.ToUpper(f.Name) == "ISSUE_TEMPLATE" {
+			return true
+		}
+	}
+	return m.FindFile([]string{"", ".github", "docs"}, func(name string) bool {
+		return strings.HasPrefix(strings.ToUpper(name), "ISSUE_TEMPLATE")
+	}) != nil
+}
+
+// Workflows returns the GitHub Actions workflow files of the repository.
+func (m RepoMetadata) Workflows() []RepoFile {
+	var result []RepoFile
+	for _, f := range m.Files {
+		if f.Type != FileTypeFile || f.Path != ".github/workflows/"+f.Name {
+			continue
+		}
+		if strings.HasSuffix(f.Name, ".yml") || strings.HasSuffix(f.Name, ".yaml") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// CodeOwners returns the CODEOWNERS file of the repository, or nil if there is none.
+func (m RepoMetadata) CodeOwners() *RepoFile {
+	return m.FindFile([]string{".github", "", "docs"}, func(name string) bool {
+		return name == "CODEOWNERS"
+	})
+}
+
+const repoMetadataQuery = `query($login: String!, $cursor: String, $pageSize: Int!) {
+  repositoryOwner(login: $login) {
+    repositories(first: $pageSize, after: $cursor, ownerAffiliations: [OWNER]) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        name
+        visibility
+        repositoryTopics(first: 100) {
+          nodes {
+            topic {
+              name
+            }
+          }
+        }
+        licenseInfo {
+          key
+          name
+          spdxId
+          url
+        }
+        defaultBranchRef {
+          name
+          branchProtectionRule {
+            pattern
+            requiresApprovingReviews
+            requiredApprovingReviewCount
+            requiresCodeOwnerReviews
+            dismissesStaleReviews
+            requiresStatusChecks
+            requiresStrictStatusChecks
+            requiresCommitSignatures
+            requiresLinearHistory
+            isAdminEnforced
+            allowsForcePushes
+            allowsDeletions
+          }
+        }
+        rootTree: object(expression: "HEAD:") {
+          ...treeEntries
+        }
+        githubTree: object(expression: "HEAD:.github") {
+          ...treeEntries
+        }
+        workflowsTree: object(expression: "HEAD:.github/workflows") {
+          ...treeEntries
+        }
+        docsTree: object(expression: "HEAD:docs") {
+          ...treeEntries
+        }
+      }
+    }
+  }
+}
+
+fragment treeEntries on Tree {
+  entries {
+    name
+    path
+    type
+    object {
+      ... on Blob {
+        byteSize
+      }
+    }
+  }
+}`
+
+type repoMetadataTree struct {
+	Entries []struct {
+		Name   string `json:"name"`
+		Path   string `json:"path"`
+		Type   string `json:"type"`
+		Object *struct {
+			ByteSize int `json:"byteSize"`
+		} `json:"object"`
+	} `json:"entries"`
+}
+
+type repoMetadataResponse struct {
+	RepositoryOwner *struct {
+		Repositories struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []struct {
+				Name             string `json:"name"`
+				Visibility       string `json:"visibility"`
+				RepositoryTopics struct {
+					Nodes []struct {
+						Topic struct {
+							Name string `json:"name"`
+						} `json:"topic"`
+					} `json:"nodes"`
+				} `json:"repositoryTopics"`
+				LicenseInfo *struct {
+					Key    string `json:"key"`
+					Name   string `json:"name"`
+					SpdxId string `json:"spdxId"`
+					Url    string `json:"url"`
+				} `json:"licenseInfo"`
+				DefaultBranchRef *struct {
+					Name                 string                        `json:"name"`
+					BranchProtectionRule *repoMetadataBranchProtection `json:"branchProtectionRule"`
+				} `json:"defaultBranchRef"`
+				RootTree      *repoMetadataTree `json:"rootTree"`
+				GithubTree    *repoMetadataTree `json:"githubTree"`
+				WorkflowsTree *repoMetadataTree `json:"workflowsTree"`
+				DocsTree      *repoMetadataTree `json:"docsTree"`
+			} `json:"nodes"`
+		} `json:"repositories"`
+	} `json:"repositoryOwner"`
+}
+
+type repoMetadataBranchProtection struct {
+	Pattern                      string `json:"pattern"`
+	RequiresApprovingReviews     bool   `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount int    `json:"requiredApprovingReviewCount"`
+	RequiresCodeOwnerReviews     bool   `json:"requiresCodeOwnerReviews"`
+	DismissesStaleReviews        bool   `json:"dismissesStaleReviews"`
+	RequiresStatusChecks         bool   `json:"requiresStatusChecks"`
+	RequiresStrictStatusChecks   bool   `json:"requiresStrictStatusChecks"`
+	RequiresCommitSignatures     bool   `json:"requiresCommitSignatures"`
+	RequiresLinearHistory        bool   `json:"requiresLinearHistory"`
+	IsAdminEnforced              bool   `json:"isAdminEnforced"`
+	AllowsForcePushes            bool   `json:"allowsForcePushes"`
+	AllowsDeletions              bool   `json:"allowsDeletions"`
+}
+
+func (b repoMetadataBranchProtection) toBranchProtection() *BranchProtection {
+	return &BranchProtection{
+		Pattern:                      b.Pattern,
+		RequiresApprovingReviews:     b.RequiresApprovingReviews,
+		RequiredApprovingReviewCount: b.RequiredApprovingReviewCount,
+		RequiresCodeOwnerReviews:     b.RequiresCodeOwnerReviews,
+		DismissesStaleReviews:        b.DismissesStaleReviews,
+		RequiresStatusChecks:         b.RequiresStatusChecks,
+		RequiresStrictStatusChecks:   b.RequiresStrictStatusChecks,
+		RequiresCommitSignatures:     b.RequiresCommitSignatures,
+		RequiresLinearHistory:        b.RequiresLinearHistory,
+		IsAdminEnforced:              b.IsAdminEnforced,
+		AllowsForcePushes:            b.AllowsForcePushes,
+		AllowsDeletions:              b.AllowsDeletions,
+	}
+}
+
+func (t *repoMetadataTree) toRepoFiles() []RepoFile {
+	if t == nil {
+		return nil
+	}
+	var result []RepoFile
+	for _, entry := range t.Entries {
+		f := RepoFile{
+			Name: entry.Name,
+			Path: entry.Path,
+		}
+		switch entry.Type {
+		case "tree":
+			f.Type = FileTypeDir
+		case "commit":
+			f.Type = FileTypeSubmodule
+		default:
+			f.Type = FileTypeFile
+		}
+		if entry.Object != nil {
+			f.Size = entry.Object.ByteSize
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+func (c *client) GetRepoMetadata(login string, repoName string) (*RepoMetadata, error) {
+	if _, ok := c.repoMetadataCache[login]; !ok {
+		metadata, err := c.fetchRepoMetadata(login)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to fetch repository metadata for %s. (%w)", login, err)
+		}
+		c.repoMetadataCache[login] = metadata
+	}
+	metadata, ok := c.repoMetadataCache[login][repoName]
+	if !ok {
+		return nil, fmt.Errorf("No metadata found for repository %s/%s.", login, repoName)
+	}
+	return metadata, nil
+}
+
+// fetchRepoMetadata fetches the metadata of all repositories of an owner, repoMetadataPageSize repositories per query.
+func (c *client) fetchRepoMetadata(login string) (map[string]*RepoMetadata, error) {
+	result := map[string]*RepoMetadata{}
+	var cursor *string
+	for {
+		response := repoMetadataResponse{}
+		if err := graphQLRequest(
+			c,
+			repoMetadataQuery,
+			map[string]interface{}{
+				"login":    login,
+				"cursor":   cursor,
+				"pageSize": repoMetadataPageSize,
+			},
+			&response,
+		); err != nil {
+			return nil, err
+		}
+		if response.RepositoryOwner == nil {
+			return nil, fmt.Errorf("owner %s not found", login)
+		}
+		repos := response.RepositoryOwner.Repositories
+		for _, node := range repos.Nodes {
+			metadata := &RepoMetadata{
+				Name:       node.Name,
+				Visibility: strings.ToLower(node.Visibility),
+				Topics:     []string{},
+			}
+			for _, topic := range node.RepositoryTopics.Nodes {
+				metadata.Topics = append(metadata.Topics, topic.Topic.Name)
+			}
+			if node.LicenseInfo != nil {
+				metadata.License = &RepoLicense{
+					Key:  node.LicenseInfo.Key,
+					Name: node.LicenseInfo.Name,
+					URL:  node.LicenseInfo.Url,
+					SPDX: node.LicenseInfo.SpdxId,
+				}
+			}
+			if node.DefaultBranchRef != nil {
+				metadata.DefaultBranch = node.DefaultBranchRef.Name
+				if node.DefaultBranchRef.BranchProtectionRule != nil {
+					metadata.DefaultBranchProtection = node.DefaultBranchRef.BranchProtectionRule.toBranchProtection()
+				}
+			}
+			metadata.Files = append(metadata.Files, node.RootTree.toRepoFiles()...)
+			metadata.Files = append(metadata.Files, node.GithubTree.toRepoFiles()...)
+			metadata.Files = append(metadata.Files, node.WorkflowsTree.toRepoFiles()...)
+			metadata.Files = append(metadata.Files, node.DocsTree.toRepoFiles()...)
+			result[node.Name] = metadata
+		}
+		if !repos.PageInfo.HasNextPage {
+			return result, nil
+		}
+		endCursor := repos.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+}
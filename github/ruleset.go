@@ -0,0 +1,103 @@
+package github
+
+import "encoding/json"
+
+const (
+	// RulesetEnforcementActive means that the rules of the ruleset are enforced.
+	RulesetEnforcementActive = "active"
+	// RulesetEnforcementEvaluate means that violations of the rules are only reported.
+	RulesetEnforcementEvaluate = "evaluate"
+	// RulesetEnforcementDisabled means that the ruleset is not applied.
+	RulesetEnforcementDisabled = "disabled"
+)
+
+const (
+	// RulesetTargetBranch is the target of rulesets applying to branches.
+	RulesetTargetBranch = "branch"
+	// RulesetTargetTag is the target of rulesets applying to tags.
+	RulesetTargetTag = "tag"
+)
+
+const (
+	// RulesetRefDefaultBranch matches the default branch of a repository in a ref name condition.
+	RulesetRefDefaultBranch = "~DEFAULT_BRANCH"
+	// RulesetAll matches all refs or repositories in a condition.
+	RulesetAll = "~ALL"
+)
+
+// Types of the rules of a ruleset.
+const (
+	RulesetRulePullRequest          = "pull_request"
+	RulesetRuleRequiredStatusChecks = "required_status_checks"
+	RulesetRuleRequiredSignatures   = "required_signatures"
+	RulesetRuleNonFastForward       = "non_fast_forward"
+)
+
+// Ruleset is a ruleset of an organization. The list endpoint only returns a summary, the Conditions and Rules are
+// only filled in when fetching a single ruleset.
+type Ruleset struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	// Target is RulesetTargetBranch, RulesetTargetTag or "push".
+	Target string `json:"target"`
+	// Enforcement is RulesetEnforcementActive, RulesetEnforcementEvaluate or RulesetEnforcementDisabled.
+	Enforcement string             `json:"enforcement"`
+	Conditions  *RulesetConditions `json:"conditions,omitempty"`
+	Rules       []RulesetRule      `json:"rules,omitempty"`
+}
+
+// HasRule returns true if the ruleset contains a rule of the specified type.
+func (r Ruleset) HasRule(ruleType string) bool {
+	for _, rule := range r.Rules {
+		if rule.Type == ruleType {
+			return true
+		}
+	}
+	return false
+}
+
+// RulesetConditions select the refs and repositories a ruleset applies to. Only one of the repository conditions is
+// set.
+type RulesetConditions struct {
+	RefName            *RulesetPatterns                    `json:"ref_name,omitempty"`
+	RepositoryName     *RulesetRepositoryNameCondition     `json:"repository_name,omitempty"`
+	RepositoryId       *RulesetRepositoryIdCondition       `json:"repository_id,omitempty"`
+	RepositoryProperty *RulesetRepositoryPropertyCondition `json:"repository_property,omitempty"`
+}
+
+// RulesetPatterns lists the patterns a ruleset condition includes and excludes.
+type RulesetPatterns struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRepositoryNameCondition selects repositories by name patterns.
+type RulesetRepositoryNameCondition struct {
+	RulesetPatterns
+
+	// Protected prevents renaming repositories to avoid the ruleset.
+	Protected bool `json:"protected"`
+}
+
+// RulesetRepositoryIdCondition selects repositories by their ID.
+type RulesetRepositoryIdCondition struct {
+	RepositoryIds []int64 `json:"repository_ids"`
+}
+
+// RulesetRepositoryPropertyCondition selects repositories by their custom property values.
+type RulesetRepositoryPropertyCondition struct {
+	Include []RulesetPropertyTarget `json:"include"`
+	Exclude []RulesetPropertyTarget `json:"exclude"`
+}
+
+// RulesetPropertyTarget matches the values of a custom repository property.
+type RulesetPropertyTarget struct {
+	Name           string   `json:"name"`
+	PropertyValues []string `json:"property_values"`
+}
+
+// RulesetRule is a single rule of a ruleset, for example RulesetRulePullRequest. The parameters depend on the type.
+type RulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
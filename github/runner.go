@@ -0,0 +1,44 @@
+package github
+
+// RunnerGroupVisibilityAll is the visibility of a runner group that can be used by all repositories of the
+// organization.
+const RunnerGroupVisibilityAll = "all"
+
+// RunnerGroup is a group of self-hosted runners of an organization.
+type RunnerGroup struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	// Visibility is "all", "selected" or "private".
+	Visibility string `json:"visibility"`
+	Default    bool   `json:"default"`
+	// Inherited is true if the group is shared by the enterprise.
+	Inherited bool `json:"inherited"`
+	// AllowsPublicRepositories is true if public repositories can run jobs on the runners of the group.
+	AllowsPublicRepositories bool `json:"allows_public_repositories"`
+	// RestrictedToWorkflows is true if only the SelectedWorkflows can run jobs on the runners of the group.
+	RestrictedToWorkflows bool     `json:"restricted_to_workflows"`
+	SelectedWorkflows     []string `json:"selected_workflows"`
+}
+
+// RunnerLabel is a label jobs use to select a runner.
+type RunnerLabel struct {
+	Name string `json:"name"`
+	// Type is either "read-only" for labels assigned by GitHub or "custom".
+	Type string `json:"type"`
+}
+
+// Runner is a self-hosted runner. The API doesn't expose the version of the runner software.
+type Runner struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	OS   string `json:"os"`
+	// Status is either "online" or "offline".
+	Status string        `json:"status"`
+	Busy   bool          `json:"busy"`
+	Labels []RunnerLabel `json:"labels"`
+}
+
+// Offline returns true if the runner is not connected to GitHub.
+func (r Runner) Offline() bool {
+	return r.Status != "online"
+}
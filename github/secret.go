@@ -0,0 +1,46 @@
+package github
+
+import "time"
+
+// SecretType selects the secrets of a feature. The value is the path segment of the API endpoints.
+type SecretType string
+
+const (
+	SecretTypeActions    SecretType = "actions"
+	SecretTypeDependabot SecretType = "dependabot"
+	SecretTypeCodespaces SecretType = "codespaces"
+)
+
+// SecretTypes lists all secret types.
+var SecretTypes = []SecretType{SecretTypeActions, SecretTypeDependabot, SecretTypeCodespaces}
+
+// String returns the name of the feature the secrets belong to.
+func (t SecretType) String() string {
+	switch t {
+	case SecretTypeActions:
+		return "Actions"
+	case SecretTypeDependabot:
+		return "Dependabot"
+	case SecretTypeCodespaces:
+		return "Codespaces"
+	default:
+		return string(t)
+	}
+}
+
+const (
+	// SecretVisibilityAll means that all repositories, including public ones, can use the secret.
+	SecretVisibilityAll = "all"
+	// SecretVisibilityPrivate means that all private and internal repositories can use the secret.
+	SecretVisibilityPrivate = "private"
+	// SecretVisibilitySelected means that only the selected repositories can use the secret.
+	SecretVisibilitySelected = "selected"
+)
+
+// Secret is the metadata of an organization secret. The value of a secret cannot be read.
+type Secret struct {
+	Name       string    `json:"name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Visibility string    `json:"visibility"`
+}
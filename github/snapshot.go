@@ -0,0 +1,800 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SnapshotVersion is the version of the snapshot format written by the recording client. Snapshots with a different
+// version cannot be evaluated.
+const SnapshotVersion = 1
+
+// Snapshot contains the results of all API calls made through a recording client. It can be written to a file and
+// later evaluated offline using NewSnapshotClient.
+type Snapshot struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	// Org and User are the -org and -user selectors the snapshot was collected with. Evaluating the snapshot with
+	// different selectors only works if the calls they need were recorded too.
+	Org  string `json:"org,omitempty"`
+	User string `json:"user,omitempty"`
+	// Entries contains the results indexed by the method name and its parameters, for example
+	// "ListOrgRepositories/acme".
+	Entries map[string]SnapshotEntry `json:"entries"`
+}
+
+// SnapshotEntry is the recorded result of a single API call.
+type SnapshotEntry struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	// StatusCode is the HTTP status code if the error was an APIError, so rules can tell a missing endpoint from
+	// other errors.
+	StatusCode int `json:"status_code,omitempty"`
+	// RateLimited is true if the error was caused by an exhausted rate limit, so it is not mistaken for a missing
+	// permission when the snapshot is evaluated.
+	RateLimited bool `json:"rate_limited,omitempty"`
+}
+
+// ReadSnapshot decodes a snapshot and checks that its version is supported.
+func ReadSnapshot(reader io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(reader).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot (%w)", err)
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf(
+			"unsupported snapshot version %d, this version of hubcheck supports version %d",
+			snapshot.Version,
+			SnapshotVersion,
+		)
+	}
+	return snapshot, nil
+}
+
+// Write encodes the snapshot.
+func (s *Snapshot) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("failed to encode snapshot (%w)", err)
+	}
+	return nil
+}
+
+func snapshotKey(method string, params ...string) string {
+	return strings.Join(append([]string{method}, params...), "/")
+}
+
+// RecordingClient is a client that records the results of all calls into a snapshot.
+type RecordingClient interface {
+	Client
+
+	// Snapshot returns the results recorded so far.
+	Snapshot() *Snapshot
+}
+
+// NewRecordingClient creates a client that passes all calls to the backend client and records the results.
+func NewRecordingClient(backend Client) RecordingClient {
+	return &recordingClient{
+		backend: backend,
+		snapshot: &Snapshot{
+			Version:   SnapshotVersion,
+			CreatedAt: time.Now().UTC(),
+			Entries:   map[string]SnapshotEntry{},
+		},
+	}
+}
+
+type recordingClient struct {
+	backend  Client
+	lock     sync.Mutex
+	snapshot *Snapshot
+}
+
+func (r *recordingClient) Snapshot() *Snapshot {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	entries := make(map[string]SnapshotEntry, len(r.snapshot.Entries))
+	for key, entry := range r.snapshot.Entries {
+		entries[key] = entry
+	}
+	return &Snapshot{
+		Version:   r.snapshot.Version,
+		CreatedAt: r.snapshot.CreatedAt,
+		Org:       r.snapshot.Org,
+		User:      r.snapshot.User,
+		Entries:   entries,
+	}
+}
+
+// record stores the result of a call in the snapshot.
+//
+// This is a non-receiver method due to https://github.com/golang/go/issues/49085
+func record[T any](r *recordingClient, key string, result T, err error) (T, error) {
+	entry := SnapshotEntry{}
+	if err != nil {
+		entry.Error = err.Error()
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			entry.StatusCode = apiErr.StatusCode
+			entry.RateLimited = apiErr.RateLimited()
+		}
+	} else {
+		data, marshalErr := json.Marshal(result)
+		if marshalErr != nil {
+			return result, fmt.Errorf("failed to record %s (%w)", key, marshalErr)
+		}
+		entry.Result = data
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.snapshot.Entries[key] = entry
+	return result, err
+}
+
+func (r *recordingClient) ListOrganizations() ([]*Organization, error) {
+	orgs, err := r.backend.ListOrganizations()
+	for _, org := range orgs {
+		org.client = r
+	}
+	return record(r, snapshotKey("ListOrganizations"), orgs, err)
+}
+
+func (r *recordingClient) GetOrg(login string) (*Organization, error) {
+	org, err := r.backend.GetOrg(login)
+	if org != nil {
+		org.client = r
+	}
+	return record(r, snapshotKey("GetOrg", login), org, err)
+}
+
+func (r *recordingClient) ListEnterpriseOrganizations(slug string) ([]string, error) {
+	result, err := r.backend.ListEnterpriseOrganizations(slug)
+	return record(r, snapshotKey("ListEnterpriseOrganizations", slug), result, err)
+}
+
+func (r *recordingClient) GetAuthenticatedUser() (*User, error) {
+	user, err := r.backend.GetAuthenticatedUser()
+	if user != nil {
+		user.client = r
+	}
+	return record(r, snapshotKey("GetAuthenticatedUser"), user, err)
+}
+
+func (r *recordingClient) GetUser(login string) (*User, error) {
+	user, err := r.backend.GetUser(login)
+	if user != nil {
+		user.client = r
+	}
+	return record(r, snapshotKey("GetUser", login), user, err)
+}
+
+func (r *recordingClient) ListUserRepositories(login string) ([]*Repository, error) {
+	repos, err := r.backend.ListUserRepositories(login)
+	for _, repo := range repos {
+		repo.client = r
+	}
+	return record(r, snapshotKey("ListUserRepositories", login), repos, err)
+}
+
+func (r *recordingClient) GetLastUserEvent(login string) (*Event, error) {
+	result, err := r.backend.GetLastUserEvent(login)
+	return record(r, snapshotKey("GetLastUserEvent", login), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsOrgPermissions(login string) (*ActionsPermissions, error) {
+	result, err := r.backend.GetGitHubActionsOrgPermissions(login)
+	if result != nil {
+		result.client = r
+	}
+	return record(r, snapshotKey("GetGitHubActionsOrgPermissions", login), result, err)
+}
+
+func (r *recordingClient) ListOrgAdmins(login string) ([]*OrgMember, error) {
+	result, err := r.backend.ListOrgAdmins(login)
+	for _, member := range result {
+		member.client = r
+	}
+	return record(r, snapshotKey("ListOrgAdmins", login), result, err)
+}
+
+func (r *recordingClient) GetLastAuditLogEntry(login string, actor string) (*AuditLogEntry, error) {
+	result, err := r.backend.GetLastAuditLogEntry(login, actor)
+	return record(r, snapshotKey("GetLastAuditLogEntry", login, actor), result, err)
+}
+
+func (r *recordingClient) GetOrgDomainSettings(login string) (*OrgDomainSettings, error) {
+	result, err := r.backend.GetOrgDomainSettings(login)
+	return record(r, snapshotKey("GetOrgDomainSettings", login), result, err)
+}
+
+func (r *recordingClient) ListOrgHooks(login string) ([]*Hook, error) {
+	result, err := r.backend.ListOrgHooks(login)
+	return record(r, snapshotKey("ListOrgHooks", login), result, err)
+}
+
+func (r *recordingClient) ListOrgInstallations(login string) ([]*Installation, error) {
+	result, err := r.backend.ListOrgInstallations(login)
+	return record(r, snapshotKey("ListOrgInstallations", login), result, err)
+}
+
+func (r *recordingClient) ListOrgCustomRepoRoles(login string) ([]*CustomRepoRole, error) {
+	result, err := r.backend.ListOrgCustomRepoRoles(login)
+	return record(r, snapshotKey("ListOrgCustomRepoRoles", login), result, err)
+}
+
+func (r *recordingClient) ListOrgBlockedUsers(login string) ([]*OrgMember, error) {
+	result, err := r.backend.ListOrgBlockedUsers(login)
+	for _, user := range result {
+		user.client = r
+	}
+	return record(r, snapshotKey("ListOrgBlockedUsers", login), result, err)
+}
+
+func (r *recordingClient) GetOrgInteractionLimits(login string) (*InteractionLimit, error) {
+	result, err := r.backend.GetOrgInteractionLimits(login)
+	return record(r, snapshotKey("GetOrgInteractionLimits", login), result, err)
+}
+
+func (r *recordingClient) ListOrgInvitations(login string) ([]*Invitation, error) {
+	result, err := r.backend.ListOrgInvitations(login)
+	return record(r, snapshotKey("ListOrgInvitations", login), result, err)
+}
+
+func (r *recordingClient) ListOrgFailedInvitations(login string) ([]*Invitation, error) {
+	result, err := r.backend.ListOrgFailedInvitations(login)
+	return record(r, snapshotKey("ListOrgFailedInvitations", login), result, err)
+}
+
+func (r *recordingClient) ListOrgRulesets(login string) ([]*Ruleset, error) {
+	result, err := r.backend.ListOrgRulesets(login)
+	return record(r, snapshotKey("ListOrgRulesets", login), result, err)
+}
+
+func (r *recordingClient) GetOrgRuleset(login string, rulesetID int64) (*Ruleset, error) {
+	result, err := r.backend.GetOrgRuleset(login, rulesetID)
+	return record(r, snapshotKey("GetOrgRuleset", login, strconv.FormatInt(rulesetID, 10)), result, err)
+}
+
+func (r *recordingClient) ListOrgRunnerGroups(login string) ([]*RunnerGroup, error) {
+	result, err := r.backend.ListOrgRunnerGroups(login)
+	return record(r, snapshotKey("ListOrgRunnerGroups", login), result, err)
+}
+
+func (r *recordingClient) ListOrgSecrets(login string, secretType SecretType) ([]*Secret, error) {
+	result, err := r.backend.ListOrgSecrets(login, secretType)
+	return record(r, snapshotKey("ListOrgSecrets", login, string(secretType)), result, err)
+}
+
+func (r *recordingClient) ListOrgSecretRepositories(
+	login string,
+	secretType SecretType,
+	name string,
+) ([]*Repository, error) {
+	repos, err := r.backend.ListOrgSecretRepositories(login, secretType, name)
+	for _, repo := range repos {
+		repo.client = r
+	}
+	return record(r, snapshotKey("ListOrgSecretRepositories", login, string(secretType), name), repos, err)
+}
+
+func (r *recordingClient) ListOrgVariables(login string) ([]*Variable, error) {
+	result, err := r.backend.ListOrgVariables(login)
+	return record(r, snapshotKey("ListOrgVariables", login), result, err)
+}
+
+func (r *recordingClient) ListOrgVariableRepositories(login string, name string) ([]*Repository, error) {
+	repos, err := r.backend.ListOrgVariableRepositories(login, name)
+	for _, repo := range repos {
+		repo.client = r
+	}
+	return record(r, snapshotKey("ListOrgVariableRepositories", login, name), repos, err)
+}
+
+func (r *recordingClient) ListOrgRunnerGroupRunners(login string, groupID int64) ([]*Runner, error) {
+	result, err := r.backend.ListOrgRunnerGroupRunners(login, groupID)
+	return record(r, snapshotKey("ListOrgRunnerGroupRunners", login, strconv.FormatInt(groupID, 10)), result, err)
+}
+
+func (r *recordingClient) ListRecentOrgHookDeliveries(login string, hookID int64) ([]*HookDelivery, error) {
+	result, err := r.backend.ListRecentOrgHookDeliveries(login, hookID)
+	return record(r, snapshotKey("ListRecentOrgHookDeliveries", login, strconv.FormatInt(hookID, 10)), result, err)
+}
+
+func (r *recordingClient) ListOrgMembers(login string, filter string) ([]*OrgMember, error) {
+	result, err := r.backend.ListOrgMembers(login, filter)
+	for _, member := range result {
+		member.client = r
+	}
+	return record(r, snapshotKey("ListOrgMembers", login, filter), result, err)
+}
+
+func (r *recordingClient) ListOrgOutsideCollaborators(login string, filter string) ([]*OrgMember, error) {
+	result, err := r.backend.ListOrgOutsideCollaborators(login, filter)
+	for _, member := range result {
+		member.client = r
+	}
+	return record(r, snapshotKey("ListOrgOutsideCollaborators", login, filter), result, err)
+}
+
+func (r *recordingClient) ListOrgRepositories(login string) ([]*Repository, error) {
+	repos, err := r.backend.ListOrgRepositories(login)
+	for _, repo := range repos {
+		repo.client = r
+	}
+	return record(r, snapshotKey("ListOrgRepositories", login), repos, err)
+}
+
+func (r *recordingClient) ListOrgTeams(login string) ([]*Team, error) {
+	result, err := r.backend.ListOrgTeams(login)
+	return record(r, snapshotKey("ListOrgTeams", login), result, err)
+}
+
+func (r *recordingClient) ListTeamMembers(login string, teamSlug string, role string) ([]*OrgMember, error) {
+	result, err := r.backend.ListTeamMembers(login, teamSlug, role)
+	for _, member := range result {
+		member.client = r
+	}
+	return record(r, snapshotKey("ListTeamMembers", login, teamSlug, role), result, err)
+}
+
+func (r *recordingClient) ListTeamRepositories(login string, teamSlug string) ([]*TeamRepository, error) {
+	result, err := r.backend.ListTeamRepositories(login, teamSlug)
+	return record(r, snapshotKey("ListTeamRepositories", login, teamSlug), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsRepoPermissions(login string, repoName string) (*ActionsPermissions, error) {
+	result, err := r.backend.GetGitHubActionsRepoPermissions(login, repoName)
+	if result != nil {
+		result.client = r
+	}
+	return record(r, snapshotKey("GetGitHubActionsRepoPermissions", login, repoName), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsOrgSelectedActions(login string) (*SelectedActions, error) {
+	result, err := r.backend.GetGitHubActionsOrgSelectedActions(login)
+	return record(r, snapshotKey("GetGitHubActionsOrgSelectedActions", login), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsRepoSelectedActions(login string, repoName string) (*SelectedActions, error) {
+	result, err := r.backend.GetGitHubActionsRepoSelectedActions(login, repoName)
+	return record(r, snapshotKey("GetGitHubActionsRepoSelectedActions", login, repoName), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsOrgForkPRContributorApproval(
+	login string,
+) (*ForkPRContributorApproval, error) {
+	result, err := r.backend.GetGitHubActionsOrgForkPRContributorApproval(login)
+	return record(r, snapshotKey("GetGitHubActionsOrgForkPRContributorApproval", login), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsRepoForkPRContributorApproval(
+	login string,
+	repoName string,
+) (*ForkPRContributorApproval, error) {
+	result, err := r.backend.GetGitHubActionsRepoForkPRContributorApproval(login, repoName)
+	return record(r, snapshotKey("GetGitHubActionsRepoForkPRContributorApproval", login, repoName), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsOrgWorkflowPermissions(login string) (*WorkflowPermissions, error) {
+	result, err := r.backend.GetGitHubActionsOrgWorkflowPermissions(login)
+	return record(r, snapshotKey("GetGitHubActionsOrgWorkflowPermissions", login), result, err)
+}
+
+func (r *recordingClient) GetGitHubActionsRepoWorkflowPermissions(
+	login string,
+	repoName string,
+) (*WorkflowPermissions, error) {
+	result, err := r.backend.GetGitHubActionsRepoWorkflowPermissions(login, repoName)
+	return record(r, snapshotKey("GetGitHubActionsRepoWorkflowPermissions", login, repoName), result, err)
+}
+
+func (r *recordingClient) ListRepoDeployKeys(login string, repoName string) ([]*DeployKey, error) {
+	result, err := r.backend.ListRepoDeployKeys(login, repoName)
+	return record(r, snapshotKey("ListRepoDeployKeys", login, repoName), result, err)
+}
+
+func (r *recordingClient) ListRepoHooks(login string, repoName string) ([]*Hook, error) {
+	result, err := r.backend.ListRepoHooks(login, repoName)
+	return record(r, snapshotKey("ListRepoHooks", login, repoName), result, err)
+}
+
+func (r *recordingClient) ListRecentRepoHookDeliveries(
+	login string,
+	repoName string,
+	hookID int64,
+) ([]*HookDelivery, error) {
+	result, err := r.backend.ListRecentRepoHookDeliveries(login, repoName, hookID)
+	return record(
+		r,
+		snapshotKey("ListRecentRepoHookDeliveries", login, repoName, strconv.FormatInt(hookID, 10)),
+		result,
+		err,
+	)
+}
+
+func (r *recordingClient) ListRepoCollaborators(
+	login string,
+	repoName string,
+	affiliation string,
+) ([]*Collaborator, error) {
+	result, err := r.backend.ListRepoCollaborators(login, repoName, affiliation)
+	return record(r, snapshotKey("ListRepoCollaborators", login, repoName, affiliation), result, err)
+}
+
+func (r *recordingClient) RepoVulnerabilityAlertsEnabled(login string, repoName string) (bool, error) {
+	result, err := r.backend.RepoVulnerabilityAlertsEnabled(login, repoName)
+	return record(r, snapshotKey("RepoVulnerabilityAlertsEnabled", login, repoName), result, err)
+}
+
+func (r *recordingClient) ListContents(login string, repoName string) ([]RepoDirEntry, error) {
+	entries, err := r.backend.ListContents(login, repoName)
+	for i := range entries {
+		entries[i].c = r
+	}
+	return record(r, snapshotKey("ListContents", login, repoName), entries, err)
+}
+
+func (r *recordingClient) GetContents(login string, repoName string, path string) ([]byte, error) {
+	result, err := r.backend.GetContents(login, repoName, path)
+	return record(r, snapshotKey("GetContents", login, repoName, path), result, err)
+}
+
+func (r *recordingClient) GetRepoMetadata(login string, repoName string) (*RepoMetadata, error) {
+	result, err := r.backend.GetRepoMetadata(login, repoName)
+	return record(r, snapshotKey("GetRepoMetadata", login, repoName), result, err)
+}
+
+// NewSnapshotClient creates a client that answers all calls from the snapshot without network access. Calls that
+// are not in the snapshot fail.
+func NewSnapshotClient(snapshot *Snapshot) (Client, error) {
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	return &snapshotClient{
+		snapshot: snapshot,
+	}, nil
+}
+
+type snapshotClient struct {
+	snapshot *Snapshot
+}
+
+// replay decodes the result of a call from the snapshot.
+//
+// This is a non-receiver method due to https://github.com/golang/go/issues/49085
+func replay[T any](s *snapshotClient, key string) (T, error) {
+	var result T
+	entry, ok := s.snapshot.Entries[key]
+	if !ok {
+		return result, fmt.Errorf("%s is not in the snapshot, was the rule enabled when collecting it?", key)
+	}
+	if entry.Error != "" {
+		if entry.StatusCode != 0 {
+			apiErr := &APIError{StatusCode: entry.StatusCode}
+			if entry.RateLimited {
+				apiErr.RateLimitRemaining = "0"
+			}
+			return result, &replayedError{
+				message: entry.Error,
+				apiErr:  apiErr,
+			}
+		}
+		return result, errors.New(entry.Error)
+	}
+	if err := json.Unmarshal(entry.Result, &result); err != nil {
+		return result, fmt.Errorf("failed to decode %s from the snapshot (%w)", key, err)
+	}
+	return result, nil
+}
+
+// replayedError is a recorded error that wraps an APIError with the original status code.
+type replayedError struct {
+	message string
+	apiErr  *APIError
+}
+
+func (e *replayedError) Error() string {
+	return e.message
+}
+
+func (e *replayedError) Unwrap() error {
+	return e.apiErr
+}
+
+func (s *snapshotClient) ListOrganizations() ([]*Organization, error) {
+	orgs, err := replay[[]*Organization](s, snapshotKey("ListOrganizations"))
+	for _, org := range orgs {
+		org.client = s
+	}
+	return orgs, err
+}
+
+func (s *snapshotClient) GetOrg(login string) (*Organization, error) {
+	org, err := replay[*Organization](s, snapshotKey("GetOrg", login))
+	if org != nil {
+		org.client = s
+	}
+	return org, err
+}
+
+func (s *snapshotClient) ListEnterpriseOrganizations(slug string) ([]string, error) {
+	return replay[[]string](s, snapshotKey("ListEnterpriseOrganizations", slug))
+}
+
+func (s *snapshotClient) GetAuthenticatedUser() (*User, error) {
+	user, err := replay[*User](s, snapshotKey("GetAuthenticatedUser"))
+	if user != nil {
+		user.client = s
+	}
+	return user, err
+}
+
+func (s *snapshotClient) GetUser(login string) (*User, error) {
+	user, err := replay[*User](s, snapshotKey("GetUser", login))
+	if user != nil {
+		user.client = s
+	}
+	return user, err
+}
+
+func (s *snapshotClient) ListUserRepositories(login string) ([]*Repository, error) {
+	repos, err := replay[[]*Repository](s, snapshotKey("ListUserRepositories", login))
+	for _, repo := range repos {
+		repo.client = s
+		repo.orgLogin = login
+	}
+	return repos, err
+}
+
+func (s *snapshotClient) GetLastUserEvent(login string) (*Event, error) {
+	return replay[*Event](s, snapshotKey("GetLastUserEvent", login))
+}
+
+func (s *snapshotClient) GetGitHubActionsOrgPermissions(login string) (*ActionsPermissions, error) {
+	result, err := replay[*ActionsPermissions](s, snapshotKey("GetGitHubActionsOrgPermissions", login))
+	if result != nil {
+		result.client = s
+	}
+	return result, err
+}
+
+func (s *snapshotClient) ListOrgAdmins(login string) ([]*OrgMember, error) {
+	members, err := replay[[]*OrgMember](s, snapshotKey("ListOrgAdmins", login))
+	for _, member := range members {
+		member.client = s
+	}
+	return members, err
+}
+
+func (s *snapshotClient) GetLastAuditLogEntry(login string, actor string) (*AuditLogEntry, error) {
+	return replay[*AuditLogEntry](s, snapshotKey("GetLastAuditLogEntry", login, actor))
+}
+
+func (s *snapshotClient) GetOrgDomainSettings(login string) (*OrgDomainSettings, error) {
+	return replay[*OrgDomainSettings](s, snapshotKey("GetOrgDomainSettings", login))
+}
+
+func (s *snapshotClient) ListOrgHooks(login string) ([]*Hook, error) {
+	return replay[[]*Hook](s, snapshotKey("ListOrgHooks", login))
+}
+
+func (s *snapshotClient) ListOrgInstallations(login string) ([]*Installation, error) {
+	return replay[[]*Installation](s, snapshotKey("ListOrgInstallations", login))
+}
+
+func (s *snapshotClient) ListOrgCustomRepoRoles(login string) ([]*CustomRepoRole, error) {
+	return replay[[]*CustomRepoRole](s, snapshotKey("ListOrgCustomRepoRoles", login))
+}
+
+func (s *snapshotClient) ListOrgBlockedUsers(login string) ([]*OrgMember, error) {
+	users, err := replay[[]*OrgMember](s, snapshotKey("ListOrgBlockedUsers", login))
+	for _, user := range users {
+		user.client = s
+	}
+	return users, err
+}
+
+func (s *snapshotClient) GetOrgInteractionLimits(login string) (*InteractionLimit, error) {
+	return replay[*InteractionLimit](s, snapshotKey("GetOrgInteractionLimits", login))
+}
+
+func (s *snapshotClient) ListOrgInvitations(login string) ([]*Invitation, error) {
+	return replay[[]*Invitation](s, snapshotKey("ListOrgInvitations", login))
+}
+
+func (s *snapshotClient) ListOrgFailedInvitations(login string) ([]*Invitation, error) {
+	return replay[[]*Invitation](s, snapshotKey("ListOrgFailedInvitations", login))
+}
+
+func (s *snapshotClient) ListOrgRulesets(login string) ([]*Ruleset, error) {
+	return replay[[]*Ruleset](s, snapshotKey("ListOrgRulesets", login))
+}
+
+func (s *snapshotClient) GetOrgRuleset(login string, rulesetID int64) (*Ruleset, error) {
+	return replay[*Ruleset](s, snapshotKey("GetOrgRuleset", login, strconv.FormatInt(rulesetID, 10)))
+}
+
+func (s *snapshotClient) ListOrgRunnerGroups(login string) ([]*RunnerGroup, error) {
+	return replay[[]*RunnerGroup](s, snapshotKey("ListOrgRunnerGroups", login))
+}
+
+func (s *snapshotClient) ListOrgSecrets(login string, secretType SecretType) ([]*Secret, error) {
+	return replay[[]*Secret](s, snapshotKey("ListOrgSecrets", login, string(secretType)))
+}
+
+func (s *snapshotClient) ListOrgSecretRepositories(
+	login string,
+	secretType SecretType,
+	name string,
+) ([]*Repository, error) {
+	repos, err := replay[[]*Repository](s, snapshotKey("ListOrgSecretRepositories", login, string(secretType), name))
+	for _, repo := range repos {
+		repo.client = s
+		repo.orgLogin = login
+	}
+	return repos, err
+}
+
+func (s *snapshotClient) ListOrgVariables(login string) ([]*Variable, error) {
+	return replay[[]*Variable](s, snapshotKey("ListOrgVariables", login))
+}
+
+func (s *snapshotClient) ListOrgVariableRepositories(login string, name string) ([]*Repository, error) {
+	repos, err := replay[[]*Repository](s, snapshotKey("ListOrgVariableRepositories", login, name))
+	for _, repo := range repos {
+		repo.client = s
+		repo.orgLogin = login
+	}
+	return repos, err
+}
+
+func (s *snapshotClient) ListOrgRunnerGroupRunners(login string, groupID int64) ([]*Runner, error) {
+	return replay[[]*Runner](s, snapshotKey("ListOrgRunnerGroupRunners", login, strconv.FormatInt(groupID, 10)))
+}
+
+func (s *snapshotClient) ListRecentOrgHookDeliveries(login string, hookID int64) ([]*HookDelivery, error) {
+	return replay[[]*HookDelivery](
+		s,
+		snapshotKey("ListRecentOrgHookDeliveries", login, strconv.FormatInt(hookID, 10)),
+	)
+}
+
+func (s *snapshotClient) ListOrgMembers(login string, filter string) ([]*OrgMember, error) {
+	members, err := replay[[]*OrgMember](s, snapshotKey("ListOrgMembers", login, filter))
+	for _, member := range members {
+		member.client = s
+	}
+	return members, err
+}
+
+func (s *snapshotClient) ListOrgOutsideCollaborators(login string, filter string) ([]*OrgMember, error) {
+	members, err := replay[[]*OrgMember](s, snapshotKey("ListOrgOutsideCollaborators", login, filter))
+	for _, member := range members {
+		member.client = s
+	}
+	return members, err
+}
+
+func (s *snapshotClient) ListOrgRepositories(login string) ([]*Repository, error) {
+	repos, err := replay[[]*Repository](s, snapshotKey("ListOrgRepositories", login))
+	for _, repo := range repos {
+		repo.client = s
+		repo.orgLogin = login
+	}
+	return repos, err
+}
+
+func (s *snapshotClient) ListOrgTeams(login string) ([]*Team, error) {
+	return replay[[]*Team](s, snapshotKey("ListOrgTeams", login))
+}
+
+func (s *snapshotClient) ListTeamMembers(login string, teamSlug string, role string) ([]*OrgMember, error) {
+	members, err := replay[[]*OrgMember](s, snapshotKey("ListTeamMembers", login, teamSlug, role))
+	for _, member := range members {
+		member.client = s
+	}
+	return members, err
+}
+
+func (s *snapshotClient) ListTeamRepositories(login string, teamSlug string) ([]*TeamRepository, error) {
+	return replay[[]*TeamRepository](s, snapshotKey("ListTeamRepositories", login, teamSlug))
+}
+
+func (s *snapshotClient) GetGitHubActionsRepoPermissions(login string, repoName string) (*ActionsPermissions, error) {
+	result, err := replay[*ActionsPermissions](s, snapshotKey("GetGitHubActionsRepoPermissions", login, repoName))
+	if result != nil {
+		result.client = s
+	}
+	return result, err
+}
+
+func (s *snapshotClient) GetGitHubActionsOrgSelectedActions(login string) (*SelectedActions, error) {
+	return replay[*SelectedActions](s, snapshotKey("GetGitHubActionsOrgSelectedActions", login))
+}
+
+func (s *snapshotClient) GetGitHubActionsRepoSelectedActions(login string, repoName string) (*SelectedActions, error) {
+	return replay[*SelectedActions](s, snapshotKey("GetGitHubActionsRepoSelectedActions", login, repoName))
+}
+
+func (s *snapshotClient) GetGitHubActionsOrgForkPRContributorApproval(
+	login string,
+) (*ForkPRContributorApproval, error) {
+	return replay[*ForkPRContributorApproval](s, snapshotKey("GetGitHubActionsOrgForkPRContributorApproval", login))
+}
+
+func (s *snapshotClient) GetGitHubActionsRepoForkPRContributorApproval(
+	login string,
+	repoName string,
+) (*ForkPRContributorApproval, error) {
+	return replay[*ForkPRContributorApproval](
+		s,
+		snapshotKey("GetGitHubActionsRepoForkPRContributorApproval", login, repoName),
+	)
+}
+
+func (s *snapshotClient) GetGitHubActionsOrgWorkflowPermissions(login string) (*WorkflowPermissions, error) {
+	return replay[*WorkflowPermissions](s, snapshotKey("GetGitHubActionsOrgWorkflowPermissions", login))
+}
+
+func (s *snapshotClient) GetGitHubActionsRepoWorkflowPermissions(
+	login string,
+	repoName string,
+) (*WorkflowPermissions, error) {
+	return replay[*WorkflowPermissions](s, snapshotKey("GetGitHubActionsRepoWorkflowPermissions", login, repoName))
+}
+
+func (s *snapshotClient) ListRepoDeployKeys(login string, repoName string) ([]*DeployKey, error) {
+	return replay[[]*DeployKey](s, snapshotKey("ListRepoDeployKeys", login, repoName))
+}
+
+func (s *snapshotClient) ListRepoHooks(login string, repoName string) ([]*Hook, error) {
+	return replay[[]*Hook](s, snapshotKey("ListRepoHooks", login, repoName))
+}
+
+func (s *snapshotClient) ListRecentRepoHookDeliveries(
+	login string,
+	repoName string,
+	hookID int64,
+) ([]*HookDelivery, error) {
+	return replay[[]*HookDelivery](
+		s,
+		snapshotKey("ListRecentRepoHookDeliveries", login, repoName, strconv.FormatInt(hookID, 10)),
+	)
+}
+
+func (s *snapshotClient) ListRepoCollaborators(
+	login string,
+	repoName string,
+	affiliation string,
+) ([]*Collaborator, error) {
+	return replay[[]*Collaborator](s, snapshotKey("ListRepoCollaborators", login, repoName, affiliation))
+}
+
+func (s *snapshotClient) RepoVulnerabilityAlertsEnabled(login string, repoName string) (bool, error) {
+	return replay[bool](s, snapshotKey("RepoVulnerabilityAlertsEnabled", login, repoName))
+}
+
+func (s *snapshotClient) ListContents(login string, repoName string) ([]RepoDirEntry, error) {
+	entries, err := replay[[]RepoDirEntry](s, snapshotKey("ListContents", login, repoName))
+	for i := range entries {
+		entries[i].c = s
+		entries[i].orgID = login
+		entries[i].repoID = repoName
+	}
+	return entries, err
+}
+
+func (s *snapshotClient) GetContents(login string, repoName string, path string) ([]byte, error) {
+	return replay[[]byte](s, snapshotKey("GetContents", login, repoName, path))
+}
+
+func (s *snapshotClient) GetRepoMetadata(login string, repoName string) (*RepoMetadata, error) {
+	return replay[*RepoMetadata](s, snapshotKey("GetRepoMetadata", login, repoName))
+}
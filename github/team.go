@@ -0,0 +1,48 @@
+package github
+
+const (
+	// TeamPrivacySecret means that the team is only visible to its members and the organization owners.
+	TeamPrivacySecret = "secret"
+	// TeamPrivacyClosed means that the team is visible to all members of the organization.
+	TeamPrivacyClosed = "closed"
+)
+
+const (
+	// TeamRoleMaintainer selects the maintainers of a team.
+	TeamRoleMaintainer = "maintainer"
+	// TeamRoleMember selects the regular members of a team.
+	TeamRoleMember = "member"
+	// TeamRoleAll selects all members of a team.
+	TeamRoleAll = "all"
+)
+
+// Team is a team of an organization.
+type Team struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	// Privacy is either TeamPrivacySecret or TeamPrivacyClosed.
+	Privacy string `json:"privacy"`
+	// Parent is the team this team is nested in, if any. Members of this team inherit the access of the parent.
+	Parent *Team `json:"parent"`
+}
+
+// TeamRepository is a repository a team has access to.
+type TeamRepository struct {
+	Name        string          `json:"name"`
+	FullName    string          `json:"full_name"`
+	Archived    bool            `json:"archived"`
+	Permissions RepoPermissions `json:"permissions"`
+	// RoleName is the name of the role, for example "admin", "write" or the name of a custom repository role.
+	RoleName string `json:"role_name"`
+}
+
+// Role returns the role name of the team on the repository. If the API didn't return one, it is derived from the
+// permissions.
+func (r TeamRepository) Role() string {
+	if r.RoleName != "" {
+		return r.RoleName
+	}
+	return r.Permissions.Role()
+}
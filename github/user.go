@@ -0,0 +1,51 @@
+package github
+
+import "time"
+
+type User struct {
+	client Client `json:"-"`
+
+	Login       string    `json:"login"`
+	Id          int       `json:"id"`
+	NodeId      string    `json:"node_id"`
+	AvatarUrl   string    `json:"avatar_url"`
+	Url         string    `json:"url"`
+	HtmlUrl     string    `json:"html_url"`
+	ReposUrl    string    `json:"repos_url"`
+	Type        string    `json:"type"`
+	SiteAdmin   bool      `json:"site_admin"`
+	Name        string    `json:"name"`
+	Company     string    `json:"company"`
+	Blog        string    `json:"blog"`
+	Location    string    `json:"location"`
+	Email       string    `json:"email"`
+	Bio         string    `json:"bio"`
+	PublicRepos int       `json:"public_repos"`
+	Followers   int       `json:"followers"`
+	Following   int       `json:"following"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// TwoFactorAuthentication is only returned for the authenticated user.
+	TwoFactorAuthentication *bool `json:"two_factor_authentication,omitempty"`
+}
+
+// NewUser binds the user data to a client. This is useful for constructing test data, for example using a
+// githubmock.Client.
+func NewUser(c Client, user User) *User {
+	user.client = c
+	return &user
+}
+
+func (u User) GetLogin() string {
+	return u.Login
+}
+
+func (u User) OwnerType() OwnerType {
+	return OwnerTypeUser
+}
+
+// ListRepositories lists the repositories owned by the user. Private repositories are only included for the
+// authenticated user.
+func (u User) ListRepositories() ([]*Repository, error) {
+	return u.client.ListUserRepositories(u.Login)
+}
@@ -0,0 +1,13 @@
+package github
+
+import "time"
+
+// Variable is the metadata of an organization GitHub Actions variable. The value is readable by anyone with access to
+// the variable, but it is deliberately not decoded, so it does not end up in reports or snapshots.
+type Variable struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Visibility is one of SecretVisibilityAll, SecretVisibilityPrivate or SecretVisibilitySelected.
+	Visibility string `json:"visibility"`
+}
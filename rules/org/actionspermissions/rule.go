@@ -3,17 +3,22 @@ package actionspermissions
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/selectedactions"
 )
 
-func New() hubcheck.OrgRule {
-	return &rule{}
+func New(policy selectedactions.Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
 }
 
 type rule struct {
+	policy selectedactions.Policy
 }
 
 func (r rule) DocURL() string {
@@ -68,7 +73,30 @@ func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
 	}
 	switch actionsPermissions.AllowedActions {
 	case "selected":
-		return okResult, nil
+		selected, err := org.GetSelectedActions()
+		if err != nil {
+			return nil, err
+		}
+		violations := r.policy.Evaluate(selected)
+		if len(violations) == 0 {
+			return okResult, nil
+		}
+		var lines []string
+		for _, violation := range violations {
+			lines = append(lines, "- "+violation.String())
+		}
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Error,
+				Title:       "The allowed GitHub Actions violate your policy",
+				Description: r.Description() + "\n\nThe following entries of the allowlist violate your policy:\n\n" + strings.Join(lines, "\n"),
+				FixURL: fmt.Sprintf(
+					"https://github.com/organizations/%s/settings/actions",
+					url.QueryEscape(org.Login),
+				),
+				DocURL: r.DocURL(),
+			},
+		}, nil
 	case "local_only":
 		return okResult, nil
 	default:
@@ -0,0 +1,149 @@
+package appinstallations
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes which GitHub Apps are approved.
+type Policy struct {
+	// ApprovedApps lists the slugs of the apps that may have broad access to the organization.
+	ApprovedApps []string `json:"approved_apps"`
+}
+
+// sensitivePermissions are the permissions that allow an app to take over a repository or its workflows.
+var sensitivePermissions = []string{"administration", "contents", "workflows"}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "GitHub App installations"
+}
+
+func (r rule) Description() string {
+	return "GitHub Apps act on your repositories independently of your members. Apps with write access to the administration, contents or workflows of all repositories can change code and settings everywhere, so they should be reviewed and approved."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-programmatic-access-to-your-organization/reviewing-github-apps-installed-in-your-organization"
+}
+
+func (r rule) ID() string {
+	return "github-app-installations"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	installations, err := org.ListInstallations()
+	if err != nil {
+		return nil, err
+	}
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/installations",
+		url.QueryEscape(org.Login),
+	)
+	if len(installations) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No GitHub Apps installed",
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	approved := map[string]bool{}
+	for _, slug := range r.policy.ApprovedApps {
+		approved[strings.ToLower(slug)] = true
+	}
+
+	var inventory []string
+	var results []hubcheck.RuleResult
+	for _, installation := range installations {
+		inventory = append(inventory, fmt.Sprintf(
+			"- `%s`: %s repositories; permissions: %s; events: %s",
+			installation.AppSlug,
+			installation.RepositorySelection,
+			formatPermissions(installation.Permissions),
+			formatList(installation.Events),
+		))
+
+		if installation.RepositorySelection != "all" || approved[strings.ToLower(installation.AppSlug)] {
+			continue
+		}
+		var sensitive []string
+		for _, permission := range sensitivePermissions {
+			if access := installation.Permissions[permission]; access == "write" || access == "admin" {
+				sensitive = append(sensitive, fmt.Sprintf("%s: %s", permission, access))
+			}
+		}
+		if len(sensitive) == 0 {
+			continue
+		}
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Warning,
+			Title: fmt.Sprintf("GitHub App %s has write access to all repositories", installation.AppSlug),
+			Description: fmt.Sprintf(
+				"%s\n\n%s is not on the list of approved apps and has the following permissions on all repositories: %s",
+				r.Description(),
+				installation.AppSlug,
+				strings.Join(sensitive, ", "),
+			),
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/installations/%d",
+				url.QueryEscape(org.Login),
+				installation.Id,
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+	sort.Strings(inventory)
+
+	return append(
+		[]hubcheck.RuleResult{
+			{
+				Level: hublog.Info,
+				Title: fmt.Sprintf("%d GitHub Apps installed", len(installations)),
+				Description: fmt.Sprintf(
+					"%s\n\nThe following apps are installed:\n\n%s",
+					r.Description(),
+					strings.Join(inventory, "\n"),
+				),
+				FixURL: fixURL,
+				DocURL: r.DocURL(),
+			},
+		},
+		results...,
+	), nil
+}
+
+func formatPermissions(permissions map[string]string) string {
+	var result []string
+	for permission, access := range permissions {
+		result = append(result, permission+"="+access)
+	}
+	sort.Strings(result)
+	return formatList(result)
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
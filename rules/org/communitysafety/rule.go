@@ -0,0 +1,241 @@
+package communitysafety
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// defaultsRepository is the repository containing the default community health files of an organization.
+const defaultsRepository = ".github"
+
+// healthFile is a community health file that can be provided by a repository or the defaults repository.
+type healthFile struct {
+	name string
+	// article is the indefinite article to use before the name, if any.
+	article string
+	exists  func(metadata *github.RepoMetadata) bool
+}
+
+var healthFiles = []healthFile{
+	{"code of conduct", "a ", func(metadata *github.RepoMetadata) bool { return metadata.CodeOfConduct() != nil }},
+	{"security policy", "a ", func(metadata *github.RepoMetadata) bool { return metadata.SecurityPolicy() != nil }},
+	{"contributing guide", "a ", func(metadata *github.RepoMetadata) bool { return metadata.ContributingGuide() != nil }},
+	{"issue templates", "", func(metadata *github.RepoMetadata) bool { return metadata.HasIssueTemplates() }},
+}
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Community safety"
+}
+
+func (r rule) Description() string {
+	return "Open source organizations should set expectations for their community and be prepared for abuse. A code of conduct, security policy, contributing guide and issue templates should be available for every public repository, either in the repository itself or in the .github repository of the organization. Interaction limits and blocking help during incidents."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/communities/setting-up-your-project-for-healthy-contributions/creating-a-default-community-health-file"
+}
+
+func (r rule) ID() string {
+	return "community-safety"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	repos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+	var defaults *github.RepoMetadata
+	var publicRepos []*github.Repository
+	for _, repo := range repos {
+		if repo.Visibility != "public" {
+			continue
+		}
+		if repo.Name == defaultsRepository {
+			defaults, err = repo.GetMetadata()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !repo.Archived && !repo.Fork {
+			publicRepos = append(publicRepos, repo)
+		}
+	}
+	// The rule only applies to organizations with public repositories.
+	if len(publicRepos) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Info,
+				Title:       "Not applicable, the organization has no public repositories",
+				Description: r.Description(),
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	results, err := r.checkHealthFiles(org, defaults, publicRepos)
+	if err != nil {
+		return nil, err
+	}
+
+	moderationURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/interaction_limits",
+		url.QueryEscape(org.Login),
+	)
+	limit, err := org.GetInteractionLimits()
+	switch {
+	case github.IsForbidden(err):
+		// Only organization owners can read the interaction limits.
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Warning,
+			Title:       "Interaction limits could not be checked, are you an owner?",
+			Description: r.Description(),
+			FixURL:      moderationURL,
+			DocURL:      r.DocURL(),
+		})
+	case err != nil && !github.IsNotFound(err):
+		return nil, err
+	case err != nil:
+	case limit.Active():
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Notice,
+			Title: fmt.Sprintf(
+				"Interactions are limited to %s until %s",
+				strings.ReplaceAll(limit.Limit, "_", " "),
+				limit.ExpiresAt.Format("2006-01-02"),
+			),
+			Description: r.Description(),
+			FixURL:      moderationURL,
+			DocURL:      r.DocURL(),
+		})
+	default:
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Info,
+			Title:       "No interaction limits are active",
+			Description: r.Description() + "\n\nDuring an incident, such as a wave of spam or harassment, you can temporarily limit who can comment, open issues and create pull requests in all public repositories.",
+			FixURL:      moderationURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+
+	blockedURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/blocked_users",
+		url.QueryEscape(org.Login),
+	)
+	blocked, err := org.ListBlockedUsers()
+	switch {
+	case github.IsForbidden(err):
+		// Only organization owners can list the blocked users.
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Warning,
+			Title:       "Blocked users could not be listed, are you an owner?",
+			Description: r.Description(),
+			FixURL:      blockedURL,
+			DocURL:      r.DocURL(),
+		})
+	case err != nil && !github.IsNotFound(err):
+		return nil, err
+	}
+	if len(blocked) > 0 {
+		var logins []string
+		for _, user := range blocked {
+			logins = append(logins, fmt.Sprintf("`%s`", user.Login))
+		}
+		sort.Strings(logins)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("%d blocked users", len(blocked)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe following users are blocked: %s",
+				r.Description(),
+				strings.Join(logins, ", "),
+			),
+			FixURL: blockedURL,
+			DocURL: r.DocURL(),
+		})
+	}
+	return results, nil
+}
+
+// checkHealthFiles reports the community health files that are neither in the defaults repository nor in each public
+// repository.
+func (r rule) checkHealthFiles(
+	org *github.Organization,
+	defaults *github.RepoMetadata,
+	publicRepos []*github.Repository,
+) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf("https://github.com/%s/%s", url.PathEscape(org.Login), defaultsRepository)
+	if defaults == nil {
+		fixURL = fmt.Sprintf("https://github.com/organizations/%s/repositories/new", url.QueryEscape(org.Login))
+	}
+	missing := map[string][]string{}
+	for _, repo := range publicRepos {
+		metadata, err := repo.GetMetadata()
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range healthFiles {
+			if !file.exists(metadata) {
+				missing[file.name] = append(missing[file.name], repo.Name)
+			}
+		}
+	}
+
+	var results []hubcheck.RuleResult
+	for _, file := range healthFiles {
+		switch {
+		case defaults != nil && file.exists(defaults):
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Notice,
+				Title: fmt.Sprintf(
+					"The %s repository provides %sdefault %s",
+					defaultsRepository,
+					file.article,
+					file.name,
+				),
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			})
+		case len(missing[file.name]) == 0:
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Notice,
+				Title:       fmt.Sprintf("All public repositories have %s%s", file.article, file.name),
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			})
+		default:
+			repoNames := missing[file.name]
+			sort.Strings(repoNames)
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf("%d public repositories have no %s", len(repoNames), file.name),
+				Description: fmt.Sprintf(
+					"%s\n\nNeither the %s repository nor the following repositories provide %s%s: %s",
+					r.Description(),
+					defaultsRepository,
+					file.article,
+					file.name,
+					strings.Join(repoNames, ", "),
+				),
+				FixURL: fixURL,
+				DocURL: r.DocURL(),
+			})
+		}
+	}
+	return results, nil
+}
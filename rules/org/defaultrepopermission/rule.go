@@ -3,17 +3,62 @@ package defaultrepopermission
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
 )
 
-func New() hubcheck.OrgRule {
-	return &rule{}
+// Policy describes the highest permissions organization members may have on all repositories.
+type Policy struct {
+	// MaxBasePermission is the highest allowed base permission: "none", "read", "write" or "admin".
+	MaxBasePermission string `json:"max_base_permission"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxBasePermission: "write",
+	}
+}
+
+// basePermissions orders the base permissions from the least to the most access.
+var basePermissions = []string{"none", "read", "write", "admin"}
+
+// Validate returns an error if the policy contains an unknown base permission. Unknown permissions would otherwise
+// rank the highest and silently disable the check.
+func (p Policy) Validate() error {
+	for _, permission := range basePermissions {
+		if p.MaxBasePermission == permission {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"invalid max_base_permission %q, expected one of %s",
+		p.MaxBasePermission,
+		strings.Join(basePermissions, ", "),
+	)
+}
+
+// dangerousPermissions are the fine-grained permissions of custom repository roles that allow bypassing or weakening
+// the protections of a repository.
+var dangerousPermissions = []string{
+	"bypass_branch_protection",
+	"edit_repo_protections",
+	"manage_webhooks",
+	"manage_deploy_keys",
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
 }
 
 type rule struct {
+	policy Policy
 }
 
 func (r rule) Name() string {
@@ -21,7 +66,7 @@ func (r rule) Name() string {
 }
 
 func (r rule) Description() string {
-	return "To ensure that organization members cannot carry out destructive actions, such as force-pushing and thereby deleting history, the default repository permissions should not be set to admin."
+	return "To ensure that organization members cannot carry out destructive actions, such as force-pushing and thereby deleting history, the default repository permissions should be as low as possible. Custom repository roles should not grant permissions to bypass branch protections or to manage webhooks and deploy keys, since these can be used to escalate access."
 }
 
 func (r rule) DocURL() string {
@@ -33,44 +78,167 @@ func (r rule) ID() string {
 }
 
 func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/member_privileges",
+		url.QueryEscape(org.Login),
+	)
 	if org.DefaultRepositoryPermission == "" {
 		return []hubcheck.RuleResult{
 			{
 				Level:       hublog.Warning,
 				Title:       "OrgRule execution failed",
 				Description: "Are you an admin?",
-				FixURL: fmt.Sprintf(
-					"https://github.com/organizations/%s/settings/member_privileges",
-					url.QueryEscape(org.Login),
-				),
-				DocURL: r.DocURL(),
-			},
-		}, nil
-	}
-	if org.DefaultRepositoryPermission != "admin" {
-		return []hubcheck.RuleResult{
-			{
-				Level:       hublog.Notice,
-				Title:       fmt.Sprintf("Default repository permissions are %s", org.DefaultRepositoryPermission),
-				Description: r.Description(),
-				FixURL: fmt.Sprintf(
-					"https://github.com/organizations/%s/settings/member_privileges",
-					url.QueryEscape(org.Login),
-				),
-				DocURL: r.DocURL(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
 			},
 		}, nil
 	}
-	return []hubcheck.RuleResult{
-		{
-			Level:       hublog.Error,
+
+	var results []hubcheck.RuleResult
+	if rank(org.DefaultRepositoryPermission) > rank(r.policy.MaxBasePermission) {
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf(
+				"Default repository permissions are %s, at most %s is allowed",
+				org.DefaultRepositoryPermission,
+				r.policy.MaxBasePermission,
+			),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	} else {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
 			Title:       fmt.Sprintf("Default repository permissions are %s", org.DefaultRepositoryPermission),
 			Description: r.Description(),
-			FixURL: fmt.Sprintf(
-				"https://github.com/organizations/%s/settings/member_privileges",
-				url.QueryEscape(org.Login),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+
+	roleResults, err := r.checkCustomRoles(org)
+	if err != nil {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Warning,
+			Title:       "Custom repository roles could not be checked",
+			Description: fmt.Sprintf("%s\n\n%v", r.Description(), err),
+			FixURL:      fmt.Sprintf("https://github.com/organizations/%s/settings/roles", url.QueryEscape(org.Login)),
+			DocURL:      r.DocURL(),
+		})
+		return results, nil
+	}
+	return append(results, roleResults...), nil
+}
+
+// checkCustomRoles reports custom repository roles with dangerous permissions and the teams and users holding them.
+func (r rule) checkCustomRoles(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	roles, err := org.ListCustomRepoRoles()
+	if err != nil {
+		// Custom repository roles are only available on GitHub Enterprise.
+		if github.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	dangerous := map[string][]string{}
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			for _, dangerousPermission := range dangerousPermissions {
+				if permission == dangerousPermission {
+					dangerous[role.Name] = append(dangerous[role.Name], permission)
+				}
+			}
+		}
+	}
+	if len(dangerous) == 0 {
+		return nil, nil
+	}
+
+	// The holders are only listed if a role is dangerous, since that requires a request for every team and repository.
+	// If they cannot be listed, for example because the token lacks access to some repositories, the roles are still
+	// reported.
+	holders, holdersErr := roleHolders(org, dangerous)
+	var roleNames []string
+	for roleName := range dangerous {
+		roleNames = append(roleNames, roleName)
+	}
+	sort.Strings(roleNames)
+
+	var results []hubcheck.RuleResult
+	for _, roleName := range roleNames {
+		holderList := "The role is not assigned to any team or user."
+		if holdersErr != nil {
+			holderList = fmt.Sprintf("The teams and users holding the role could not be listed (%v).", holdersErr)
+		} else if len(holders[roleName]) > 0 {
+			sort.Strings(holders[roleName])
+			holderList = "The role is assigned to:\n\n" + strings.Join(holders[roleName], "\n")
+		}
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Warning,
+			Title: fmt.Sprintf("Custom repository role %s has dangerous permissions", roleName),
+			Description: fmt.Sprintf(
+				"%s\n\nThe role grants the following permissions: %s\n\n%s",
+				r.Description(),
+				strings.Join(dangerous[roleName], ", "),
+				holderList,
 			),
+			FixURL: fmt.Sprintf("https://github.com/organizations/%s/settings/roles", url.QueryEscape(org.Login)),
 			DocURL: r.DocURL(),
-		},
-	}, nil
+		})
+	}
+	return results, nil
+}
+
+// roleHolders lists the teams and direct collaborators holding each of the roles, keyed by role name. The API cannot
+// be queried by role, so this lists the repositories of every team and the direct collaborators of every repository.
+func roleHolders(org *github.Organization, roles map[string][]string) (map[string][]string, error) {
+	holders := map[string][]string{}
+	teams, err := org.ListTeams()
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range teams {
+		repos, err := org.ListTeamRepositories(team.Slug)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			if _, ok := roles[repo.RoleName]; ok {
+				holders[repo.RoleName] = append(
+					holders[repo.RoleName],
+					fmt.Sprintf("- team `%s` on %s", team.Slug, repo.Name),
+				)
+			}
+		}
+	}
+	repos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repos {
+		collaborators, err := repo.ListCollaborators(github.AffiliationDirect)
+		if err != nil {
+			return nil, err
+		}
+		for _, collaborator := range collaborators {
+			if _, ok := roles[collaborator.RoleName]; ok {
+				holders[collaborator.RoleName] = append(
+					holders[collaborator.RoleName],
+					fmt.Sprintf("- user `%s` on %s", collaborator.Login, repo.Name),
+				)
+			}
+		}
+	}
+	return holders, nil
+}
+
+// rank orders base permissions. Unknown permissions rank the highest, so they are reported.
+func rank(permission string) int {
+	for i, p := range basePermissions {
+		if p == permission {
+			return i
+		}
+	}
+	return len(basePermissions)
 }
@@ -0,0 +1,47 @@
+package defaultrepopermission
+
+import (
+	"testing"
+)
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		permission string
+		max        string
+		allowed    bool
+	}{
+		{"none", "none", true},
+		{"read", "none", false},
+		{"read", "write", true},
+		{"write", "write", true},
+		{"admin", "write", false},
+		{"admin", "admin", true},
+		{"unknown", "admin", false},
+	}
+	for _, tc := range tests {
+		if allowed := rank(tc.permission) <= rank(tc.max); allowed != tc.allowed {
+			t.Errorf("expected %s to be allowed=%t with a maximum of %s", tc.permission, tc.allowed, tc.max)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]bool{
+		"none":  true,
+		"read":  true,
+		"write": true,
+		"admin": true,
+		"":      false,
+		"Write": false,
+		"push":  false,
+	}
+	for permission, valid := range tests {
+		err := Policy{MaxBasePermission: permission}.Validate()
+		if (err == nil) != valid {
+			t.Errorf("unexpected validation result for %q: %v", permission, err)
+		}
+	}
+	if err := DefaultPolicy().Validate(); err != nil {
+		t.Errorf("the default policy is invalid (%v)", err)
+	}
+}
@@ -0,0 +1,186 @@
+package deploykeys
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes how deploy keys should be maintained.
+type Policy struct {
+	// RotationDays is the number of days after which a deploy key should be replaced. If it is 0, the age of keys is
+	// not checked.
+	RotationDays int `json:"rotation_days"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		RotationDays: 365,
+	}
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "Deploy keys"
+}
+
+func (r rule) Description() string {
+	return "Deploy keys are often created once and forgotten. Keys with write access can push to a repository without any user behind them, so they should be read-only unless pushing is required, rotated regularly, and never shared between repositories."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/authentication/connecting-to-github-with-ssh/managing-deploy-keys#deploy-keys"
+}
+
+func (r rule) ID() string {
+	return "deploy-keys"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	repos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -r.policy.RotationDays)
+	// usage maps public keys to the repositories they are used in.
+	usage := map[string][]string{}
+	var results []hubcheck.RuleResult
+	var skipped []string
+	keyCount := 0
+	writeCount := 0
+	for _, repo := range repos {
+		keys, err := repo.ListDeployKeys()
+		if err != nil {
+			// Only repository admins can list deploy keys, GitHub responds with a 404 or a 403 to everyone else.
+			if !github.IsNotFound(err) && !github.IsForbidden(err) {
+				return nil, err
+			}
+			skipped = append(skipped, repo.Name)
+			continue
+		}
+		fixURL := fmt.Sprintf(
+			"https://github.com/%s/%s/settings/keys",
+			url.PathEscape(org.Login),
+			url.PathEscape(repo.Name),
+		)
+		for _, key := range keys {
+			keyCount++
+			usage[publicKey(key.Key)] = append(usage[publicKey(key.Key)], repo.Name)
+			if !key.ReadOnly {
+				writeCount++
+				results = append(results, hubcheck.RuleResult{
+					Level:       hublog.Error,
+					Repository:  repo.Name,
+					Title:       fmt.Sprintf("Deploy key %s has write access", key.Title),
+					Description: fmt.Sprintf("%s\n\n%s", r.Description(), describe(key)),
+					FixURL:      fixURL,
+					DocURL:      r.DocURL(),
+				})
+			}
+			if r.policy.RotationDays > 0 && key.CreatedAt.Before(cutoff) {
+				results = append(results, hubcheck.RuleResult{
+					Level:       hublog.Warning,
+					Repository:  repo.Name,
+					Title:       fmt.Sprintf("Deploy key %s is older than %d days", key.Title, r.policy.RotationDays),
+					Description: fmt.Sprintf("%s\n\n%s", r.Description(), describe(key)),
+					FixURL:      fixURL,
+					DocURL:      r.DocURL(),
+				})
+			}
+		}
+	}
+
+	var reused []string
+	for _, repoNames := range usage {
+		if len(repoNames) > 1 {
+			sort.Strings(repoNames)
+			reused = append(reused, "- "+strings.Join(repoNames, ", "))
+		}
+	}
+	if len(reused) > 0 {
+		sort.Strings(reused)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf("%d deploy keys are used in more than one repository", len(reused)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe same key is used in each of the following groups of repositories:\n\n%s",
+				r.Description(),
+				strings.Join(reused, "\n"),
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+
+	summary := fmt.Sprintf(
+		"%s\n\nYour organization has %d deploy keys in %d repositories, %d of them with write access.",
+		r.Description(),
+		keyCount,
+		len(repos)-len(skipped),
+		writeCount,
+	)
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		summary += fmt.Sprintf(
+			"\n\nThe deploy keys of the following repositories could not be listed, are you an admin? %s",
+			strings.Join(skipped, ", "),
+		)
+	}
+	level := hublog.Info
+	if keyCount == 0 && len(skipped) == 0 {
+		level = hublog.Notice
+	}
+	return append(
+		[]hubcheck.RuleResult{
+			{
+				Level:       level,
+				Title:       fmt.Sprintf("%d deploy keys", keyCount),
+				Description: summary,
+				DocURL:      r.DocURL(),
+			},
+		},
+		results...,
+	), nil
+}
+
+// publicKey returns the key type and data of a public key without the comment.
+func publicKey(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	return strings.Join(fields, " ")
+}
+
+func describe(key *github.DeployKey) string {
+	lastUsed := "never"
+	if key.LastUsed != nil {
+		lastUsed = key.LastUsed.Format("2006-01-02")
+	}
+	addedBy := key.AddedBy
+	if addedBy == "" {
+		addedBy = "unknown"
+	}
+	return fmt.Sprintf(
+		"The key was added by `%s` on %s and last used %s.",
+		addedBy,
+		key.CreatedAt.Format("2006-01-02"),
+		lastUsed,
+	)
+}
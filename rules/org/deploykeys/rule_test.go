@@ -0,0 +1,54 @@
+package deploykeys_test
+
+import (
+	"strings"
+	"testing"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/deploykeys"
+)
+
+func run(t *testing.T, fault githubtest.Fault) ([]hubcheck.RuleResult, error) {
+	t.Helper()
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{
+			Organization: github.Organization{Login: "acme"},
+			Repositories: []*githubtest.Repository{
+				{Repository: github.Repository{Name: "app"}},
+				{Repository: github.Repository{Name: "secret"}},
+			},
+		},
+	}})
+	defer srv.Close()
+	if err := srv.Inject("repos/acme/secret/keys", fault); err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deploykeys.New(deploykeys.DefaultPolicy()).Run(org)
+}
+
+func TestForbiddenRepositoryIsSkipped(t *testing.T) {
+	results, err := run(t, githubtest.Fault{StatusCode: 403})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || !strings.Contains(results[0].Description, "could not be listed") {
+		t.Fatalf("the skipped repository was not reported: %v", results)
+	}
+}
+
+func TestRateLimitIsReported(t *testing.T) {
+	if _, err := run(t, githubtest.Fault{RateLimited: true}); !github.IsRateLimited(err) {
+		t.Fatalf("expected a rate limit error, got %v", err)
+	}
+}
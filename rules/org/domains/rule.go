@@ -0,0 +1,199 @@
+package domains
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Settings are the settings an organization is expected to have.
+type Settings struct {
+	// RequireWebCommitSignoff requires contributors to sign off commits made on the web interface.
+	RequireWebCommitSignoff bool `json:"require_web_commit_signoff"`
+	// RequireVerifiedDomains requires at least one verified domain.
+	RequireVerifiedDomains bool `json:"require_verified_domains"`
+	// RestrictNotifications requires email notifications to be restricted to verified and approved domains.
+	RestrictNotifications bool `json:"restrict_notifications"`
+}
+
+// Policy contains the default settings and the settings of individual organizations.
+type Policy struct {
+	Settings
+
+	// Organizations maps organization logins to their settings. The settings of an organization listed here
+	// replace the defaults entirely.
+	Organizations map[string]Settings `json:"organizations"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		Settings: Settings{
+			RequireWebCommitSignoff: true,
+			RequireVerifiedDomains:  true,
+		},
+	}
+}
+
+// For returns the settings of an organization.
+func (p Policy) For(login string) Settings {
+	for orgLogin, settings := range p.Organizations {
+		if strings.EqualFold(orgLogin, login) {
+			return settings
+		}
+	}
+	return p.Settings
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "Web commit sign-off and verified domains"
+}
+
+func (r rule) Description() string {
+	return "Requiring sign-off on web commits records that contributors agree to your contribution terms. Verified domains prove that your organization owns its email domains, and restricting email notifications to them keeps private repository content from being sent to personal mailboxes."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-organization-settings/verifying-or-approving-a-domain-for-your-organization"
+}
+
+func (r rule) ID() string {
+	return "web-commit-signoff-and-domains"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	settings := r.policy.For(org.Login)
+	signoffFixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/repository-defaults",
+		url.QueryEscape(org.Login),
+	)
+	var results []hubcheck.RuleResult
+	if org.WebCommitSignoffRequired == nil {
+		if settings.RequireWebCommitSignoff {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Warning,
+				Title:       "Web commit sign-off could not be checked, are you an owner?",
+				Description: r.Description(),
+				FixURL:      signoffFixURL,
+				DocURL:      r.DocURL(),
+			})
+		}
+	} else {
+		results = append(results, r.check(
+			*org.WebCommitSignoffRequired,
+			settings.RequireWebCommitSignoff,
+			"Web commit sign-off is required",
+			"Web commit sign-off is not required",
+			signoffFixURL,
+		))
+	}
+
+	fixURL := fmt.Sprintf("https://github.com/organizations/%s/settings/domains", url.QueryEscape(org.Login))
+	domainSettings, err := org.GetDomainSettings()
+	if err != nil {
+		if !settings.RequireVerifiedDomains && !settings.RestrictNotifications {
+			return results, nil
+		}
+		return append(results, hubcheck.RuleResult{
+			Level:       hublog.Warning,
+			Title:       "Domains could not be checked",
+			Description: err.Error(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}), nil
+	}
+
+	var verified []string
+	var approved []string
+	for _, domain := range domainSettings.Domains {
+		switch {
+		case domain.IsVerified:
+			verified = append(verified, domain.Domain)
+		case domain.IsApproved:
+			approved = append(approved, domain.Domain)
+		}
+	}
+	sort.Strings(verified)
+	sort.Strings(approved)
+	results = append(results, r.check(
+		len(verified) > 0,
+		settings.RequireVerifiedDomains,
+		fmt.Sprintf("%d verified domains", len(verified)),
+		"No verified domains",
+		fixURL,
+	))
+	if len(verified) > 0 || len(approved) > 0 {
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: "Organization domains",
+			Description: fmt.Sprintf(
+				"%s\n\n- Verified domains: %s\n- Approved domains: %s",
+				r.Description(),
+				list(verified),
+				list(approved),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		})
+	}
+	results = append(results, r.check(
+		domainSettings.NotificationsRestricted,
+		settings.RestrictNotifications,
+		"Email notifications are restricted to verified and approved domains",
+		"Email notifications are not restricted to verified and approved domains",
+		fixURL,
+	))
+	return results, nil
+}
+
+// check reports a setting. Settings the policy doesn't require are reported as information only.
+func (r rule) check(enabled bool, required bool, enabledTitle string, disabledTitle string, fixURL string) hubcheck.RuleResult {
+	switch {
+	case enabled:
+		return hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       enabledTitle,
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}
+	case required:
+		return hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Title:       disabledTitle,
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}
+	default:
+		return hubcheck.RuleResult{
+			Level:       hublog.Info,
+			Title:       disabledTitle,
+			Description: "This setting is not required by your policy.",
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}
+	}
+}
+
+func list(domains []string) string {
+	if len(domains) == 0 {
+		return "none"
+	}
+	return strings.Join(domains, ", ")
+}
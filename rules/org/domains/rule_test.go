@@ -0,0 +1,58 @@
+package domains_test
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/domains"
+)
+
+func signoffLevel(t *testing.T, required *bool) hublog.Level {
+	t.Helper()
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{Organization: github.Organization{Login: "acme", WebCommitSignoffRequired: required}},
+	}})
+	defer srv.Close()
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := domains.New(domains.DefaultPolicy()).Run(org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range results {
+		if result.FixURL == "https://github.com/organizations/acme/settings/repository-defaults" {
+			return result.Level
+		}
+	}
+	t.Fatalf("no web commit sign-off result in %v", results)
+	return ""
+}
+
+func TestWebCommitSignoff(t *testing.T) {
+	enabled := true
+	disabled := false
+	tests := []struct {
+		name     string
+		required *bool
+		expected hublog.Level
+	}{
+		{"required", &enabled, hublog.Notice},
+		{"not required", &disabled, hublog.Error},
+		{"not visible", nil, hublog.Warning},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if level := signoffLevel(t, tc.required); level != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, level)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package invitations
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes how long invitations may stay pending.
+type Policy struct {
+	// MaxAgeDays is the number of days after which a pending invitation should be revoked. If it is 0, the age of
+	// invitations is not checked.
+	MaxAgeDays int `json:"max_age_days"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAgeDays: 7,
+	}
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "Organization invitations"
+}
+
+func (r rule) Description() string {
+	return "Pending invitations are easy to forget, but still grant access to your organization once they are accepted. Old invitations and invitations to become an owner should be revoked unless they are still needed, and failed invitations should be cleaned up."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-membership-in-your-organization/canceling-or-editing-an-invitation-to-join-your-organization"
+}
+
+func (r rule) ID() string {
+	return "organization-invitations"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	pendingURL := fmt.Sprintf("https://github.com/orgs/%s/people/pending_invitations", url.PathEscape(org.Login))
+	failedURL := fmt.Sprintf("https://github.com/orgs/%s/people/failed_invitations", url.PathEscape(org.Login))
+
+	invitations, err := org.ListInvitations()
+	if err != nil {
+		return nil, err
+	}
+	failedInvitations, err := org.ListFailedInvitations()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []hubcheck.RuleResult
+	if len(invitations) > 0 {
+		var lines []string
+		for _, invitation := range invitations {
+			lines = append(lines, "- "+describe(invitation))
+		}
+		sort.Strings(lines)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("%d pending invitations", len(invitations)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe following invitations are pending:\n\n%s",
+				r.Description(),
+				strings.Join(lines, "\n"),
+			),
+			FixURL: pendingURL,
+			DocURL: r.DocURL(),
+		})
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -r.policy.MaxAgeDays)
+	for _, invitation := range invitations {
+		if invitation.Role == github.InvitationRoleAdmin {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Error,
+				Title:       fmt.Sprintf("%s is invited to become an owner", invitation.Invitee()),
+				Description: fmt.Sprintf("%s\n\nInvitation: %s", r.Description(), describe(invitation)),
+				FixURL:      pendingURL,
+				DocURL:      r.DocURL(),
+			})
+		}
+		if r.policy.MaxAgeDays > 0 && invitation.CreatedAt.Before(cutoff) {
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf(
+					"The invitation of %s is pending for more than %d days",
+					invitation.Invitee(),
+					r.policy.MaxAgeDays,
+				),
+				Description: fmt.Sprintf("%s\n\nInvitation: %s", r.Description(), describe(invitation)),
+				FixURL:      pendingURL,
+				DocURL:      r.DocURL(),
+			})
+		}
+	}
+
+	if len(failedInvitations) > 0 {
+		var lines []string
+		for _, invitation := range failedInvitations {
+			line := "- " + describe(invitation)
+			if invitation.FailedAt != nil {
+				line += fmt.Sprintf(", failed on %s", invitation.FailedAt.Format("2006-01-02"))
+			}
+			if invitation.FailedReason != "" {
+				line += ": " + invitation.FailedReason
+			}
+			lines = append(lines, line)
+		}
+		sort.Strings(lines)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Notice,
+			Title: fmt.Sprintf("%d failed invitations", len(failedInvitations)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe following invitations failed:\n\n%s",
+				r.Description(),
+				strings.Join(lines, "\n"),
+			),
+			FixURL: failedURL,
+			DocURL: r.DocURL(),
+		})
+	}
+
+	if len(results) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No pending invitations",
+				Description: r.Description(),
+				FixURL:      pendingURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+	return results, nil
+}
+
+// describe returns the invitee, role, inviter and creation date of an invitation.
+func describe(invitation *github.Invitation) string {
+	inviter := invitation.Inviter.Login
+	if inviter == "" {
+		inviter = "unknown"
+	}
+	return fmt.Sprintf(
+		"`%s` as %s, invited by `%s` on %s",
+		invitation.Invitee(),
+		invitation.Role,
+		inviter,
+		invitation.CreatedAt.Format("2006-01-02"),
+	)
+}
@@ -0,0 +1,99 @@
+package invitations_test
+
+import (
+	"testing"
+	"time"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/invitations"
+)
+
+func run(t *testing.T, org *githubtest.Organization) []hubcheck.RuleResult {
+	t.Helper()
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{org}})
+	defer srv.Close()
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := c.GetOrg(org.Login)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := invitations.New(invitations.DefaultPolicy()).Run(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+func levels(results []hubcheck.RuleResult) map[hublog.Level]int {
+	result := map[hublog.Level]int{}
+	for _, r := range results {
+		result[r.Level]++
+	}
+	return result
+}
+
+func TestNoInvitations(t *testing.T) {
+	results := run(t, &githubtest.Organization{Organization: github.Organization{Login: "acme"}})
+	if len(results) != 1 || results[0].Level != hublog.Notice {
+		t.Fatalf("expected a single notice, got %v", results)
+	}
+}
+
+func TestInvitations(t *testing.T) {
+	failedAt := time.Now().AddDate(0, 0, -1)
+	results := run(t, &githubtest.Organization{
+		Organization: github.Organization{Login: "acme"},
+		Invitations: []github.Invitation{
+			{Login: "new", Role: "direct_member", CreatedAt: time.Now()},
+			{Login: "old", Role: "direct_member", CreatedAt: time.Now().AddDate(0, 0, -30)},
+			{Email: "boss@example.com", Role: github.InvitationRoleAdmin, CreatedAt: time.Now()},
+		},
+		FailedInvitations: []github.Invitation{
+			{Login: "gone", Role: "direct_member", CreatedAt: failedAt, FailedAt: &failedAt},
+		},
+	})
+
+	expected := map[hublog.Level]int{
+		// The list of pending invitations.
+		hublog.Info: 1,
+		// The owner invitation.
+		hublog.Error: 1,
+		// The invitation older than 7 days.
+		hublog.Warning: 1,
+		// The list of failed invitations.
+		hublog.Notice: 1,
+	}
+	actual := levels(results)
+	for level, count := range expected {
+		if actual[level] != count {
+			t.Fatalf("expected %d %s results, got %v", count, level, results)
+		}
+	}
+}
+
+func TestInvitationsUnavailable(t *testing.T) {
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{Organization: github.Organization{Login: "acme"}},
+	}})
+	defer srv.Close()
+	if err := srv.Inject("orgs/acme/invitations", githubtest.Fault{StatusCode: 403}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := invitations.New(invitations.DefaultPolicy()).Run(org); err == nil {
+		t.Fatal("the rule did not report the API error")
+	}
+}
@@ -0,0 +1,122 @@
+package memberprivileges
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes which member privileges are acceptable in the organization. The zero value allows none of them.
+type Policy struct {
+	// AllowPublicRepositories permits members to create public repositories.
+	AllowPublicRepositories bool `json:"allow_public_repositories"`
+	// AllowPrivateForks permits members to fork private and internal repositories.
+	AllowPrivateForks bool `json:"allow_private_forks"`
+	// AllowPublicPages permits members to publish public GitHub Pages sites.
+	AllowPublicPages bool `json:"allow_public_pages"`
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "Member privileges"
+}
+
+func (r rule) Description() string {
+	return "Members who can create public repositories, fork private repositories or publish public GitHub Pages sites can accidentally expose internal code and data. These privileges should only be granted if your policy allows them."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-organization-settings/restricting-repository-creation-in-your-organization"
+}
+
+func (r rule) ID() string {
+	return "member-privileges"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/member_privileges",
+		url.QueryEscape(org.Login),
+	)
+	// The member privilege fields are only returned to organization admins, the same as the default repository
+	// permission.
+	if org.DefaultRepositoryPermission == "" {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Warning,
+				Title:       "OrgRule execution failed",
+				Description: "Are you an admin?",
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	publicRepositories := org.MembersCanCreatePublicRepositories ||
+		org.MembersAllowedRepositoryCreationType == "all"
+	publicPages := org.MembersCanCreatePages && org.MembersCanCreatePublicPages
+
+	return []hubcheck.RuleResult{
+		r.check(
+			publicRepositories,
+			r.policy.AllowPublicRepositories,
+			"Members can create public repositories",
+			"Members cannot create public repositories",
+			fixURL,
+		),
+		r.check(
+			org.MembersCanForkPrivateRepositories,
+			r.policy.AllowPrivateForks,
+			"Members can fork private repositories",
+			"Members cannot fork private repositories",
+			fixURL,
+		),
+		r.check(
+			publicPages,
+			r.policy.AllowPublicPages,
+			"Members can create public GitHub Pages sites",
+			"Members cannot create public GitHub Pages sites",
+			fixURL,
+		),
+	}, nil
+}
+
+func (r rule) check(granted bool, allowed bool, grantedTitle string, deniedTitle string, fixURL string) hubcheck.RuleResult {
+	if !granted {
+		return hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       deniedTitle,
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}
+	}
+	if allowed {
+		return hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       grantedTitle,
+			Description: "This privilege is allowed by your policy.",
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}
+	}
+	return hubcheck.RuleResult{
+		Level:       hublog.Error,
+		Title:       grantedTitle,
+		Description: r.Description(),
+		FixURL:      fixURL,
+		DocURL:      r.DocURL(),
+	}
+}
@@ -2,19 +2,48 @@ package org
 
 import (
 	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/config"
 	"go.debugged.it/hubcheck/rules/org/actionspermissions"
+	"go.debugged.it/hubcheck/rules/org/appinstallations"
+	"go.debugged.it/hubcheck/rules/org/communitysafety"
 	"go.debugged.it/hubcheck/rules/org/defaultrepopermission"
+	"go.debugged.it/hubcheck/rules/org/deploykeys"
+	"go.debugged.it/hubcheck/rules/org/domains"
+	"go.debugged.it/hubcheck/rules/org/invitations"
+	"go.debugged.it/hubcheck/rules/org/memberprivileges"
 	"go.debugged.it/hubcheck/rules/org/orgadmins"
+	"go.debugged.it/hubcheck/rules/org/outsidecollaborators"
+	"go.debugged.it/hubcheck/rules/org/rulesets"
+	"go.debugged.it/hubcheck/rules/org/runnergroups"
+	"go.debugged.it/hubcheck/rules/org/secrets"
+	"go.debugged.it/hubcheck/rules/org/securitydefaults"
+	"go.debugged.it/hubcheck/rules/org/teams"
 	"go.debugged.it/hubcheck/rules/org/twofactor"
+	"go.debugged.it/hubcheck/rules/org/webhooks"
 	"go.debugged.it/hubcheck/rules/org/workflowapprovals"
+	"go.debugged.it/hubcheck/rules/org/workflowpermissions"
 )
 
-func New() []hubcheck.OrgRule {
+func New(cfg config.Config) []hubcheck.OrgRule {
 	return []hubcheck.OrgRule{
 		twofactor.New(),
-		defaultrepopermission.New(),
-		actionspermissions.New(),
+		defaultrepopermission.New(cfg.DefaultRepoPermission),
+		actionspermissions.New(cfg.SelectedActions),
+		workflowpermissions.New(),
 		workflowapprovals.New(),
-		orgadmins.New(),
+		orgadmins.New(cfg.OrgAdmins),
+		memberprivileges.New(cfg.MemberPrivileges),
+		outsidecollaborators.New(),
+		webhooks.New(),
+		appinstallations.New(cfg.AppInstallations),
+		runnergroups.New(),
+		secrets.New(cfg.Secrets),
+		teams.New(cfg.Teams),
+		invitations.New(cfg.Invitations),
+		securitydefaults.New(),
+		rulesets.New(),
+		domains.New(cfg.Domains),
+		deploykeys.New(cfg.DeployKeys),
+		communitysafety.New(),
 	}
 }
@@ -3,25 +3,54 @@ package orgadmins
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
+	"time"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
 )
 
-func New() hubcheck.OrgRule {
-	return &rule{}
+// Policy describes the expected administrators of the organization.
+type Policy struct {
+	// MinAdmins is the minimum number of administrators.
+	MinAdmins int `json:"min_admins"`
+	// MaxAdmins is the maximum number of administrators.
+	MaxAdmins int `json:"max_admins"`
+	// ExpectedAdmins lists the logins allowed to be administrators. If it is empty, any member may be an
+	// administrator.
+	ExpectedAdmins []string `json:"expected_admins"`
+	// DormantDays is the number of days without activity after which an administrator is considered dormant. If it is
+	// 0, activity is not checked.
+	DormantDays int `json:"dormant_days"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		MinAdmins:   2,
+		MaxAdmins:   5,
+		DormantDays: 90,
+	}
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
 }
 
 type rule struct {
+	policy Policy
 }
 
 func (r rule) Name() string {
-	return "Organizations should have between 2 and 5 administrators"
+	return "Organization administrators"
 }
 
 func (r rule) Description() string {
-	return "If an organization has only one administrator it is easy to lose access to it. If an organization has too many administrators it means that permissions are handled too liberally."
+	return "If an organization has only one administrator it is easy to lose access to it. If an organization has too many administrators it means that permissions are handled too liberally. Administrators should be known and active, dormant owner accounts are an easy target for account takeovers."
 }
 
 func (r rule) DocURL() string {
@@ -37,35 +66,177 @@ func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
 	if err != nil {
 		return nil, err
 	}
-	if len(members) == 1 {
-		return []hubcheck.RuleResult{
-			{
+	fixURL := fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login))
+
+	var results []hubcheck.RuleResult
+	switch {
+	case len(members) < r.policy.MinAdmins:
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf(
+				"Too few admins (%d) in your organization, at least %d are required",
+				len(members),
+				r.policy.MinAdmins,
+			),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	case r.policy.MaxAdmins > 0 && len(members) > r.policy.MaxAdmins:
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf(
+				"Too many admins (%d) in your organization, at most %d are allowed",
+				len(members),
+				r.policy.MaxAdmins,
+			),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	default:
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       fmt.Sprintf("%d admins in your organization", len(members)),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+
+	if len(r.policy.ExpectedAdmins) > 0 {
+		expected := map[string]bool{}
+		for _, login := range r.policy.ExpectedAdmins {
+			expected[strings.ToLower(login)] = true
+		}
+		for _, member := range members {
+			if expected[strings.ToLower(member.Login)] {
+				continue
+			}
+			results = append(results, hubcheck.RuleResult{
 				Level:       hublog.Error,
-				Title:       "Your organization has only one admin",
+				Title:       fmt.Sprintf("%s is an admin, but is not on the list of expected admins", member.Login),
 				Description: r.Description(),
-				FixURL:      fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login)),
+				FixURL:      fixURL,
 				DocURL:      r.DocURL(),
-			},
-		}, nil
+			})
+		}
 	}
-	if len(members) > 5 {
-		return []hubcheck.RuleResult{
-			{
-				Level:       hublog.Error,
-				Title:       fmt.Sprintf("Too many admins (%d) in your organization", len(members)),
-				Description: r.Description(),
-				FixURL:      fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login)),
+
+	if r.policy.DormantDays > 0 {
+		activityResults, err := r.checkActivity(org, members, fixURL)
+		if err != nil {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Warning,
+				Title:       "The activity of the admins could not be checked",
+				Description: fmt.Sprintf("%s\n\n%v", r.Description(), err),
+				FixURL:      fixURL,
 				DocURL:      r.DocURL(),
-			},
-		}, nil
+			})
+		} else {
+			results = append(results, activityResults...)
+		}
+	}
+	return results, nil
+}
+
+// checkActivity reports the last activity of each admin from the audit log. If the audit log is not available, for
+// example without GitHub Enterprise or without the read:audit_log scope, the public events of the admin are used
+// instead, which don't include activity in private repositories. Admins without public activity are then only reported
+// as possibly dormant.
+func (r rule) checkActivity(
+	org *github.Organization,
+	members []*github.OrgMember,
+	fixURL string,
+) ([]hubcheck.RuleResult, error) {
+	cutoff := time.Now().AddDate(0, 0, -r.policy.DormantDays)
+	auditLogAvailable := true
+	var lines []string
+	var dormant []string
+	// possiblyDormant contains the admins whose activity was checked using their public events only.
+	var possiblyDormant []string
+	for _, member := range members {
+		var lastActivity *time.Time
+		source := "audit log"
+		if auditLogAvailable {
+			entry, err := org.GetLastAuditLogEntry(member.Login)
+			switch {
+			case err == nil:
+				if entry != nil {
+					t := entry.Time()
+					lastActivity = &t
+				}
+			case github.IsNotFound(err) || github.IsForbidden(err):
+				auditLogAvailable = false
+			default:
+				return nil, err
+			}
+		}
+		if !auditLogAvailable {
+			source = "public events"
+			event, err := member.GetLastEvent()
+			if err != nil && !github.IsNotFound(err) {
+				return nil, err
+			}
+			if event != nil {
+				lastActivity = &event.CreatedAt
+			}
+		}
+
+		if lastActivity == nil {
+			lines = append(lines, fmt.Sprintf("- `%s`: no activity found in the %s", member.Login, source))
+		} else {
+			lines = append(lines, fmt.Sprintf(
+				"- `%s`: %s (%s)",
+				member.Login,
+				lastActivity.Format("2006-01-02"),
+				source,
+			))
+		}
+		if lastActivity == nil || lastActivity.Before(cutoff) {
+			if auditLogAvailable {
+				dormant = append(dormant, member.Login)
+			} else {
+				possiblyDormant = append(possiblyDormant, member.Login)
+			}
+		}
 	}
-	return []hubcheck.RuleResult{
+	sort.Strings(lines)
+
+	results := []hubcheck.RuleResult{
 		{
-			Level:       hublog.Notice,
-			Title:       fmt.Sprintf("%d admins in your organization", len(members)),
-			Description: r.Description(),
-			FixURL:      fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login)),
-			DocURL:      r.DocURL(),
+			Level: hublog.Info,
+			Title: "Last activity of the admins",
+			Description: fmt.Sprintf(
+				"The admins of your organization were last active at the following dates. Activity in private repositories is only visible if the audit log is available.\n\n%s",
+				strings.Join(lines, "\n"),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
 		},
-	}, nil
+	}
+	for _, login := range dormant {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Warning,
+			Title:       fmt.Sprintf("Admin %s looks dormant", login),
+			Description: fmt.Sprintf("%s had no activity in the last %d days. %s", login, r.policy.DormantDays, r.Description()),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	for _, login := range possiblyDormant {
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("Admin %s may be dormant", login),
+			Description: fmt.Sprintf(
+				"%s had no public activity in the last %d days. The audit log is not available, so activity in private repositories and in the organization settings could not be checked. %s",
+				login,
+				r.policy.DormantDays,
+				r.Description(),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		})
+	}
+	return results, nil
 }
@@ -0,0 +1,69 @@
+package orgadmins_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/orgadmins"
+)
+
+func run(t *testing.T, auditLog []github.AuditLogEntry) []hubcheck.RuleResult {
+	t.Helper()
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{
+			Organization: github.Organization{Login: "acme"},
+			Members: []githubtest.Member{
+				{OrgMember: github.OrgMember{Login: "active"}, Role: "admin"},
+				{OrgMember: github.OrgMember{Login: "idle"}, Role: "admin"},
+			},
+			AuditLog: auditLog,
+		},
+	}})
+	defer srv.Close()
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := orgadmins.New(orgadmins.DefaultPolicy()).Run(org)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+func dormancy(results []hubcheck.RuleResult) map[string]hublog.Level {
+	levels := map[string]hublog.Level{}
+	for _, result := range results {
+		if strings.Contains(result.Title, "dormant") {
+			levels[strings.Fields(result.Title)[1]] = result.Level
+		}
+	}
+	return levels
+}
+
+func TestDormantFromAuditLog(t *testing.T) {
+	results := run(t, []github.AuditLogEntry{
+		{Timestamp: time.Now().UnixMilli(), Action: "repo.create", Actor: "active"},
+		{Timestamp: time.Now().AddDate(-1, 0, 0).UnixMilli(), Action: "repo.create", Actor: "idle"},
+	})
+	levels := dormancy(results)
+	if len(levels) != 1 || levels["idle"] != hublog.Warning {
+		t.Fatalf("expected a warning for the idle admin, got %v", levels)
+	}
+}
+
+func TestDormantWithoutAuditLog(t *testing.T) {
+	levels := dormancy(run(t, nil))
+	if len(levels) != 2 || levels["active"] != hublog.Info || levels["idle"] != hublog.Info {
+		t.Fatalf("expected info results without the audit log, got %v", levels)
+	}
+}
@@ -0,0 +1,180 @@
+package outsidecollaborators
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Outside collaborators"
+}
+
+func (r rule) Description() string {
+	return "Outside collaborators are not members of your organization, so organization policies such as team membership reviews don't apply to them. They should have as little access as possible, should not administer or maintain repositories, and should have two-factor authentication enabled."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-user-access-to-your-organizations-repositories/managing-outside-collaborators"
+}
+
+func (r rule) ID() string {
+	return "outside-collaborators"
+}
+
+// access is a repository an outside collaborator has access to.
+type access struct {
+	repo string
+	role string
+	// baseRole is the base role the permissions correspond to. It differs from role for custom repository roles.
+	baseRole string
+	// privileged indicates that the collaborator can administer or maintain the repository. Custom repository roles
+	// can grant these permissions under a different name, so this is derived from the permissions, not the role name.
+	privileged bool
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf("https://github.com/orgs/%s/outside-collaborators", url.PathEscape(org.Login))
+	collaborators, err := org.ListOutsideCollaborators(github.MemberFilterAll)
+	if err != nil {
+		return nil, err
+	}
+	if len(collaborators) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No outside collaborators",
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	repos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+	accesses := map[string][]access{}
+	var skipped []string
+	for _, repo := range repos {
+		repoCollaborators, err := repo.ListCollaborators(github.AffiliationOutside)
+		if err != nil {
+			// Only users with push access can list collaborators, GitHub responds with a 404 or a 403 to everyone else.
+			if !github.IsNotFound(err) && !github.IsForbidden(err) {
+				return nil, err
+			}
+			skipped = append(skipped, repo.Name)
+			continue
+		}
+		for _, collaborator := range repoCollaborators {
+			accesses[collaborator.Login] = append(accesses[collaborator.Login], access{
+				repo:       repo.Name,
+				role:       collaborator.Role(),
+				baseRole:   collaborator.Permissions.Role(),
+				privileged: collaborator.Permissions.Admin || collaborator.Permissions.Maintain,
+			})
+		}
+	}
+
+	var logins []string
+	for _, collaborator := range collaborators {
+		logins = append(logins, collaborator.Login)
+	}
+	sort.Strings(logins)
+
+	var inventory []string
+	var results []hubcheck.RuleResult
+	for _, login := range logins {
+		var repoList []string
+		var privileged []string
+		for _, a := range accesses[login] {
+			role := a.role
+			if a.role != a.baseRole {
+				role = fmt.Sprintf("%s, based on %s", a.role, a.baseRole)
+			}
+			repoList = append(repoList, fmt.Sprintf("%s (%s)", a.repo, role))
+			if a.privileged {
+				privileged = append(privileged, fmt.Sprintf("- %s/%s (%s)", org.Login, a.repo, role))
+			}
+		}
+		if len(repoList) == 0 {
+			repoList = append(repoList, "no repositories")
+		}
+		inventory = append(inventory, fmt.Sprintf("- `%s`: %s", login, strings.Join(repoList, ", ")))
+		if len(privileged) > 0 {
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Error,
+				Title: fmt.Sprintf("Outside collaborator %s can administer or maintain repositories", login),
+				Description: fmt.Sprintf(
+					"%s\n\n%s has the following permissions:\n\n%s",
+					r.Description(),
+					login,
+					strings.Join(privileged, "\n"),
+				),
+				FixURL: fixURL,
+				DocURL: r.DocURL(),
+			})
+		}
+	}
+
+	withoutTwoFactor, err := org.ListOutsideCollaborators(github.MemberFilter2FADisabled)
+	if err != nil {
+		return nil, err
+	}
+	if len(withoutTwoFactor) > 0 {
+		var lines []string
+		for _, collaborator := range withoutTwoFactor {
+			lines = append(lines, fmt.Sprintf("- `%s`", collaborator.Login))
+		}
+		sort.Strings(lines)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf("%d outside collaborators without two-factor authentication", len(withoutTwoFactor)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe following outside collaborators don't have two-factor authentication enabled:\n\n%s",
+				r.Description(),
+				strings.Join(lines, "\n"),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		})
+	}
+
+	summary := fmt.Sprintf(
+		"%s\n\nThe outside collaborators have access to the following repositories:\n\n%s",
+		r.Description(),
+		strings.Join(inventory, "\n"),
+	)
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		summary += fmt.Sprintf(
+			"\n\nThe collaborators of the following repositories could not be listed, so the list above may be incomplete: %s",
+			strings.Join(skipped, ", "),
+		)
+	}
+	return append(
+		[]hubcheck.RuleResult{
+			{
+				Level:       hublog.Info,
+				Title:       fmt.Sprintf("%d outside collaborators", len(collaborators)),
+				Description: summary,
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		},
+		results...,
+	), nil
+}
@@ -0,0 +1,54 @@
+package outsidecollaborators_test
+
+import (
+	"strings"
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/outsidecollaborators"
+)
+
+func TestSkipsUnreadableRepositories(t *testing.T) {
+	contractor := github.OrgMember{Login: "contractor"}
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{
+			Organization:         github.Organization{Login: "acme"},
+			OutsideCollaborators: []githubtest.Member{{OrgMember: contractor}},
+			Repositories: []*githubtest.Repository{
+				{
+					Repository: github.Repository{Name: "app"},
+					Collaborators: []github.Collaborator{
+						{OrgMember: contractor, Permissions: github.RepoPermissions{Admin: true}},
+					},
+				},
+				{Repository: github.Repository{Name: "secret"}},
+			},
+		},
+	}})
+	defer srv.Close()
+	if err := srv.Inject("repos/acme/secret/collaborators", githubtest.Fault{StatusCode: 403}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := outsidecollaborators.New().Run(org)
+	if err != nil {
+		t.Fatalf("an unreadable repository aborted the rule (%v)", err)
+	}
+	if len(results) != 2 || results[0].Level != hublog.Info || results[1].Level != hublog.Error {
+		t.Fatalf("expected the inventory and an error, got %v", results)
+	}
+	if !strings.Contains(results[0].Description, "could not be listed") ||
+		!strings.Contains(results[0].Description, "secret") {
+		t.Fatalf("the skipped repository was not reported: %s", results[0].Description)
+	}
+}
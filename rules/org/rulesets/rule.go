@@ -0,0 +1,266 @@
+package rulesets
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Organization rulesets"
+}
+
+func (r rule) Description() string {
+	return "Organization rulesets enforce branch and tag policies across many repositories at once. The default branch of every repository should be covered by an active ruleset requiring pull request reviews, status checks and signed commits, and preventing force pushes."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-organization-settings/managing-rulesets-for-repositories-in-your-organization"
+}
+
+func (r rule) ID() string {
+	return "organization-rulesets"
+}
+
+// coverage describes if a ruleset applies to a repository.
+type coverage int
+
+const (
+	notCovered coverage = iota
+	covered
+	// unknown means that the ruleset selects repositories by custom properties, which are not checked.
+	unknown
+)
+
+// ruleNames are the rules reported for the default branch, in the order they are listed.
+var ruleNames = []struct {
+	ruleType string
+	name     string
+}{
+	{github.RulesetRulePullRequest, "Require pull request reviews"},
+	{github.RulesetRuleRequiredStatusChecks, "Require status checks"},
+	{github.RulesetRuleRequiredSignatures, "Require signed commits"},
+	{github.RulesetRuleNonFastForward, "Block force pushes"},
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf("https://github.com/organizations/%s/settings/rules", url.QueryEscape(org.Login))
+	summaries, err := org.ListRulesets()
+	if err != nil {
+		if !github.IsNotFound(err) {
+			return nil, err
+		}
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Info,
+				Title:       "Organization rulesets (manual check)",
+				Description: "Rulesets cannot be checked automatically for this organization, please check them manually. " + r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+	allRepos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+	var repos []*github.Repository
+	for _, repo := range allRepos {
+		if !repo.Archived {
+			repos = append(repos, repo)
+		}
+	}
+
+	var results []hubcheck.RuleResult
+	protected := map[string]bool{}
+	propertyTargets := false
+	for _, summary := range summaries {
+		ruleset, err := org.GetRuleset(summary.Id)
+		if err != nil {
+			return nil, err
+		}
+		if ruleset.Target != github.RulesetTargetBranch && ruleset.Target != github.RulesetTargetTag {
+			continue
+		}
+		var targeted []string
+		var missed []string
+		var defaultBranch []string
+		for _, repo := range repos {
+			switch repoCoverage(ruleset, repo) {
+			case covered:
+				targeted = append(targeted, repo.Name)
+				if ruleset.Target == github.RulesetTargetBranch && coversDefaultBranch(ruleset, repo) {
+					defaultBranch = append(defaultBranch, repo.Name)
+					if ruleset.Enforcement == github.RulesetEnforcementActive {
+						protected[repo.Name] = true
+					}
+				}
+			case notCovered:
+				missed = append(missed, repo.Name)
+			case unknown:
+				if ruleset.Enforcement == github.RulesetEnforcementActive {
+					propertyTargets = true
+				}
+			}
+		}
+		results = append(results, r.rulesetResult(ruleset, targeted, missed, defaultBranch, fixURL))
+	}
+
+	var unprotected []string
+	for _, repo := range repos {
+		if !protected[repo.Name] {
+			unprotected = append(unprotected, repo.Name)
+		}
+	}
+	if len(unprotected) == 0 {
+		return append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       "All repositories are covered by an active ruleset",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		}), nil
+	}
+	sort.Strings(unprotected)
+	description := fmt.Sprintf(
+		"%s\n\nThe default branch of the following repositories is not covered by an active organization ruleset: %s",
+		r.Description(),
+		strings.Join(unprotected, ", "),
+	)
+	if propertyTargets {
+		description += "\n\nSome active rulesets select repositories by custom properties, which are not checked. Please check these repositories manually."
+	}
+	return append(results, hubcheck.RuleResult{
+		Level:       hublog.Warning,
+		Title:       fmt.Sprintf("%d repositories are not covered by an active ruleset", len(unprotected)),
+		Description: description,
+		FixURL:      fixURL,
+		DocURL:      r.DocURL(),
+	}), nil
+}
+
+func (r rule) rulesetResult(
+	ruleset *github.Ruleset,
+	targeted []string,
+	missed []string,
+	defaultBranch []string,
+	fixURL string,
+) hubcheck.RuleResult {
+	level := hublog.Info
+	if ruleset.Enforcement != github.RulesetEnforcementActive {
+		level = hublog.Warning
+	}
+	lines := []string{
+		fmt.Sprintf("- Enforcement: %s", ruleset.Enforcement),
+		fmt.Sprintf("- Target: %s", ruleset.Target),
+	}
+	if ruleset.Conditions != nil && ruleset.Conditions.RepositoryProperty != nil {
+		lines = append(lines, "- Repositories: selected by custom properties, please check them manually")
+	} else {
+		lines = append(lines, fmt.Sprintf("- Targeted repositories: %s", list(targeted)))
+		lines = append(lines, fmt.Sprintf("- Missed repositories: %s", list(missed)))
+	}
+	if len(defaultBranch) > 0 {
+		for _, ruleName := range ruleNames {
+			enabled := "no"
+			if ruleset.HasRule(ruleName.ruleType) {
+				enabled = "yes"
+			}
+			lines = append(lines, fmt.Sprintf("- %s on the default branch: %s", ruleName.name, enabled))
+		}
+	}
+	return hubcheck.RuleResult{
+		Level: level,
+		Title: fmt.Sprintf("Ruleset %s is in %s mode", ruleset.Name, ruleset.Enforcement),
+		Description: fmt.Sprintf(
+			"%s\n\n%s",
+			r.Description(),
+			strings.Join(lines, "\n"),
+		),
+		FixURL: fixURL,
+		DocURL: r.DocURL(),
+	}
+}
+
+func list(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// repoCoverage returns if the repository conditions of a ruleset select the repository.
+func repoCoverage(ruleset *github.Ruleset, repo *github.Repository) coverage {
+	conditions := ruleset.Conditions
+	switch {
+	case conditions == nil:
+		return notCovered
+	case conditions.RepositoryName != nil:
+		name := conditions.RepositoryName
+		if matchesAny(name.Include, repo.Name) && !matchesAny(name.Exclude, repo.Name) {
+			return covered
+		}
+		return notCovered
+	case conditions.RepositoryId != nil:
+		for _, id := range conditions.RepositoryId.RepositoryIds {
+			if id == repo.Id {
+				return covered
+			}
+		}
+		return notCovered
+	case conditions.RepositoryProperty != nil:
+		return unknown
+	default:
+		return notCovered
+	}
+}
+
+// coversDefaultBranch returns if the ref name conditions of a ruleset select the default branch of the repository.
+func coversDefaultBranch(ruleset *github.Ruleset, repo *github.Repository) bool {
+	if ruleset.Conditions == nil || ruleset.Conditions.RefName == nil {
+		return false
+	}
+	refName := ruleset.Conditions.RefName
+	ref := "refs/heads/" + repo.DefaultBranch
+	return matchesAnyRef(refName.Include, ref) && !matchesAnyRef(refName.Exclude, ref)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == github.RulesetAll {
+			return true
+		}
+		g, err := glob.Compile(pattern)
+		if err == nil && g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyRef(patterns []string, ref string) bool {
+	for _, pattern := range patterns {
+		if pattern == github.RulesetAll || pattern == github.RulesetRefDefaultBranch {
+			return true
+		}
+		g, err := glob.Compile(pattern, '/')
+		if err == nil && g.Match(ref) {
+			return true
+		}
+	}
+	return false
+}
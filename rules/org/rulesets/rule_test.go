@@ -0,0 +1,94 @@
+package rulesets
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+func names(include []string, exclude []string) *github.RulesetConditions {
+	return &github.RulesetConditions{
+		RepositoryName: &github.RulesetRepositoryNameCondition{
+			RulesetPatterns: github.RulesetPatterns{Include: include, Exclude: exclude},
+		},
+	}
+}
+
+func TestRepoCoverage(t *testing.T) {
+	repo := &github.Repository{Id: 42, Name: "api-server"}
+	tests := []struct {
+		name       string
+		conditions *github.RulesetConditions
+		expected   coverage
+	}{
+		{"no conditions", nil, notCovered},
+		{"all", names([]string{github.RulesetAll}, nil), covered},
+		{"glob", names([]string{"api-*"}, nil), covered},
+		{"other glob", names([]string{"web-*"}, nil), notCovered},
+		{"excluded", names([]string{github.RulesetAll}, []string{"api-*"}), notCovered},
+		{
+			"ID",
+			&github.RulesetConditions{RepositoryId: &github.RulesetRepositoryIdCondition{RepositoryIds: []int64{1, 42}}},
+			covered,
+		},
+		{
+			"other ID",
+			&github.RulesetConditions{RepositoryId: &github.RulesetRepositoryIdCondition{RepositoryIds: []int64{1}}},
+			notCovered,
+		},
+		{
+			"property",
+			&github.RulesetConditions{RepositoryProperty: &github.RulesetRepositoryPropertyCondition{
+				Include: []github.RulesetPropertyTarget{{Name: "tier", PropertyValues: []string{"prod"}}},
+			}},
+			unknown,
+		},
+		{"ref names only", &github.RulesetConditions{RefName: &github.RulesetPatterns{}}, notCovered},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleset := &github.Ruleset{Conditions: tc.conditions}
+			if result := repoCoverage(ruleset, repo); result != tc.expected {
+				t.Fatalf("expected %d, got %d", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestCoversDefaultBranch(t *testing.T) {
+	repo := &github.Repository{Name: "app", DefaultBranch: "main"}
+	tests := []struct {
+		name     string
+		refName  *github.RulesetPatterns
+		expected bool
+	}{
+		{"no ref names", nil, false},
+		{"default branch", &github.RulesetPatterns{Include: []string{github.RulesetRefDefaultBranch}}, true},
+		{"all", &github.RulesetPatterns{Include: []string{github.RulesetAll}}, true},
+		{"exact", &github.RulesetPatterns{Include: []string{"refs/heads/main"}}, true},
+		{"glob", &github.RulesetPatterns{Include: []string{"refs/heads/*"}}, true},
+		{"glob across slashes", &github.RulesetPatterns{Include: []string{"refs/*"}}, false},
+		{"other branch", &github.RulesetPatterns{Include: []string{"refs/heads/release"}}, false},
+		{
+			"excluded",
+			&github.RulesetPatterns{Include: []string{github.RulesetAll}, Exclude: []string{"refs/heads/main"}},
+			false,
+		},
+		{
+			"default branch excluded",
+			&github.RulesetPatterns{
+				Include: []string{"refs/heads/*"},
+				Exclude: []string{github.RulesetRefDefaultBranch},
+			},
+			false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleset := &github.Ruleset{Conditions: &github.RulesetConditions{RefName: tc.refName}}
+			if result := coversDefaultBranch(ruleset, repo); result != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, result)
+			}
+		})
+	}
+}
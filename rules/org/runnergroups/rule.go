@@ -0,0 +1,158 @@
+package runnergroups
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Self-hosted runner groups"
+}
+
+func (r rule) Description() string {
+	return "Self-hosted runners keep state between jobs and run inside your network. If public repositories can use them, anyone opening a pull request may be able to run code on your infrastructure. Runner groups should only be available to the repositories that need them, and offline runners should be removed."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/actions/hosting-your-own-runners/managing-self-hosted-runners/managing-access-to-self-hosted-runners-using-groups"
+}
+
+func (r rule) ID() string {
+	return "self-hosted-runner-groups"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/actions/runner-groups",
+		url.QueryEscape(org.Login),
+	)
+	groups, err := org.ListRunnerGroups()
+	if err != nil {
+		if !github.IsNotFound(err) {
+			return nil, err
+		}
+		// Runner groups are not available on all plans.
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "Self-hosted runner groups are not available for this organization",
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	var results []hubcheck.RuleResult
+	var offline []string
+	runnerCount := 0
+	for _, group := range groups {
+		runners, err := org.ListRunnerGroupRunners(group.Id)
+		if err != nil {
+			return nil, err
+		}
+		runnerCount += len(runners)
+		for _, runner := range runners {
+			if runner.Offline() {
+				offline = append(offline, fmt.Sprintf("- `%s` in group %s", runner.Name, group.Name))
+			}
+		}
+		if len(runners) == 0 {
+			continue
+		}
+		if group.AllowsPublicRepositories {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Error,
+				Title:       fmt.Sprintf("Runner group %s can be used by public repositories", group.Name),
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			})
+		}
+		if group.Visibility == github.RunnerGroupVisibilityAll {
+			if group.RestrictedToWorkflows {
+				// The selected workflows limit what runs on the group, but every repository can still call them, and
+				// changes to them reach the runners as well.
+				results = append(results, hubcheck.RuleResult{
+					Level: hublog.Warning,
+					Title: fmt.Sprintf(
+						"Runner group %s can be used by all repositories through selected workflows",
+						group.Name,
+					),
+					Description: fmt.Sprintf(
+						"%s\n\nOnly the following workflows can run jobs on the group, but they can be used by every repository in the organization, and anyone who can change them can run code on the runners:\n\n- %s",
+						r.Description(),
+						strings.Join(group.SelectedWorkflows, "\n- "),
+					),
+					FixURL: fixURL,
+					DocURL: r.DocURL(),
+				})
+			} else {
+				results = append(results, hubcheck.RuleResult{
+					Level:       hublog.Error,
+					Title:       fmt.Sprintf("Runner group %s can be used by all repositories", group.Name),
+					Description: r.Description(),
+					FixURL:      fixURL,
+					DocURL:      r.DocURL(),
+				})
+			}
+		}
+	}
+	if runnerCount == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No self-hosted runners",
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	if len(offline) > 0 {
+		sort.Strings(offline)
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Warning,
+			Title: fmt.Sprintf("%d self-hosted runners are offline", len(offline)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe following runners are offline:\n\n%s",
+				r.Description(),
+				strings.Join(offline, "\n"),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		})
+	}
+	if len(results) == 0 {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       fmt.Sprintf("%d self-hosted runners are limited to selected repositories", runnerCount),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	// The API doesn't expose the version of the runners, so outdated runners can't be detected.
+	results = append(results, hubcheck.RuleResult{
+		Level:       hublog.Info,
+		Title:       "Runner versions (manual check)",
+		Description: "The runner versions cannot be checked automatically, please make sure your self-hosted runners are up to date. " + r.Description(),
+		FixURL:      fixURL,
+		DocURL:      r.DocURL(),
+	})
+	return results, nil
+}
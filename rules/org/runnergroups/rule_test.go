@@ -0,0 +1,70 @@
+package runnergroups_test
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/org/runnergroups"
+)
+
+func TestVisibility(t *testing.T) {
+	runners := []github.Runner{{Name: "runner", Status: "online"}}
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{
+			Organization: github.Organization{Login: "acme"},
+			RunnerGroups: []githubtest.RunnerGroup{
+				{
+					RunnerGroup: github.RunnerGroup{Id: 1, Name: "open", Visibility: github.RunnerGroupVisibilityAll},
+					Runners:     runners,
+				},
+				{
+					RunnerGroup: github.RunnerGroup{
+						Id:                    2,
+						Name:                  "deploy",
+						Visibility:            github.RunnerGroupVisibilityAll,
+						RestrictedToWorkflows: true,
+						SelectedWorkflows:     []string{"acme/infra/.github/workflows/deploy.yml@refs/heads/main"},
+					},
+					Runners: runners,
+				},
+				{
+					RunnerGroup: github.RunnerGroup{Id: 3, Name: "selected", Visibility: "selected"},
+					Runners:     runners,
+				},
+			},
+		},
+	}})
+	defer srv.Close()
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := runnergroups.New().Run(org)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	levels := map[string]hublog.Level{}
+	for _, result := range results {
+		levels[result.Title] = result.Level
+	}
+	expected := map[string]hublog.Level{
+		"Runner group open can be used by all repositories":                              hublog.Error,
+		"Runner group deploy can be used by all repositories through selected workflows": hublog.Warning,
+		"Runner versions (manual check)":                                                 hublog.Info,
+	}
+	if len(levels) != len(expected) {
+		t.Fatalf("unexpected results: %v", levels)
+	}
+	for title, level := range expected {
+		if levels[title] != level {
+			t.Fatalf("expected %s for %q, got %v", level, title, levels)
+		}
+	}
+}
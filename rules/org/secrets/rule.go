@@ -0,0 +1,327 @@
+package secrets
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes how organization secrets should be maintained.
+type Policy struct {
+	// RotationDays is the number of days after which a secret should be rotated. If it is 0, the age of secrets is
+	// not checked.
+	RotationDays int `json:"rotation_days"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		RotationDays: 365,
+	}
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+// credentialSuffixes are the last underscore-delimited segments of variable names that suggest the variable holds a
+// credential, for example NPM_TOKEN or AWS_SECRET_ACCESS_KEY. Only the end of the name is matched, so names such as
+// TOKEN_URL or SECRET_SCANNING_ENABLED are not reported.
+var credentialSuffixes = [][]string{
+	{"TOKEN"},
+	{"PASSWORD"},
+	{"PASSWD"},
+	{"SECRET"},
+	{"CREDENTIAL"},
+	{"CREDENTIALS"},
+	{"APIKEY"},
+	{"API", "KEY"},
+	{"ACCESS", "KEY"},
+	{"PRIVATE", "KEY"},
+	{"SECRET", "KEY"},
+}
+
+func (r rule) Name() string {
+	return "Organization secrets and variables"
+}
+
+func (r rule) Description() string {
+	return "Organization secrets for GitHub Actions, Dependabot and Codespaces should only be available to the repositories that need them. Workflows in public repositories should not have access to secrets, and secrets should be rotated regularly so leaked values don't stay valid forever. Actions variables are stored in plain text, so they must not contain credentials and should also be limited to the repositories that need them."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/actions/security-guides/using-secrets-in-github-actions"
+}
+
+func (r rule) ID() string {
+	return "organization-secrets"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	repos, err := org.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+	var publicRepos []string
+	for _, repo := range repos {
+		if repo.Visibility == "public" && !repo.Archived {
+			publicRepos = append(publicRepos, repo.Name)
+		}
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -r.policy.RotationDays)
+	var inventory []string
+	var results []hubcheck.RuleResult
+	for _, secretType := range github.SecretTypes {
+		fixURL := fmt.Sprintf(
+			"https://github.com/organizations/%s/settings/secrets/%s",
+			url.QueryEscape(org.Login),
+			string(secretType),
+		)
+		secrets, err := org.ListSecrets(secretType)
+		if err != nil {
+			if !github.IsNotFound(err) {
+				return nil, err
+			}
+			// The feature is not available for the organization.
+			continue
+		}
+		for _, secret := range secrets {
+			name := fmt.Sprintf("%s secret %s", secretType, secret.Name)
+			inventory = append(inventory, fmt.Sprintf(
+				"- `%s` (%s, visible to %s repositories, updated %s)",
+				secret.Name,
+				secretType,
+				secret.Visibility,
+				secret.UpdatedAt.Format("2006-01-02"),
+			))
+
+			if secret.Visibility == github.SecretVisibilityAll && len(publicRepos) > 0 {
+				results = append(results, hubcheck.RuleResult{
+					Level: hublog.Error,
+					Title: fmt.Sprintf("The %s is available to public repositories", name),
+					Description: fmt.Sprintf(
+						"%s\n\nThe secret is visible to all repositories, including %d public repositories.",
+						r.Description(),
+						len(publicRepos),
+					),
+					FixURL: fixURL,
+					DocURL: r.DocURL(),
+				})
+			}
+
+			if r.policy.RotationDays > 0 && secret.UpdatedAt.Before(cutoff) {
+				results = append(results, hubcheck.RuleResult{
+					Level: hublog.Warning,
+					Title: fmt.Sprintf("The %s was not rotated in the last %d days", name, r.policy.RotationDays),
+					Description: fmt.Sprintf(
+						"%s\n\nThe secret was last updated on %s.",
+						r.Description(),
+						secret.UpdatedAt.Format("2006-01-02"),
+					),
+					FixURL: fixURL,
+					DocURL: r.DocURL(),
+				})
+			}
+
+			if secret.Visibility == github.SecretVisibilitySelected {
+				selected, err := org.ListSecretRepositories(secretType, secret.Name)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, r.checkSelectedRepositories(org, selected, name, fixURL)...)
+			}
+		}
+	}
+
+	variableInventory, variableResults, err := r.checkVariables(org)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, variableResults...)
+
+	if len(inventory) == 0 && len(variableInventory) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No organization secrets or variables",
+				Description: r.Description(),
+				FixURL: fmt.Sprintf(
+					"https://github.com/organizations/%s/settings/secrets/actions",
+					url.QueryEscape(org.Login),
+				),
+				DocURL: r.DocURL(),
+			},
+		}, nil
+	}
+
+	var inventoryResults []hubcheck.RuleResult
+	if len(inventory) > 0 {
+		sort.Strings(inventory)
+		inventoryResults = append(inventoryResults, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("%d organization secrets", len(inventory)),
+			Description: fmt.Sprintf(
+				"%s\n\nYour organization has the following secrets:\n\n%s",
+				r.Description(),
+				strings.Join(inventory, "\n"),
+			),
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/secrets/actions",
+				url.QueryEscape(org.Login),
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+	if len(variableInventory) > 0 {
+		sort.Strings(variableInventory)
+		inventoryResults = append(inventoryResults, hubcheck.RuleResult{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("%d organization variables", len(variableInventory)),
+			Description: fmt.Sprintf(
+				"%s\n\nYour organization has the following Actions variables:\n\n%s",
+				r.Description(),
+				strings.Join(variableInventory, "\n"),
+			),
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/variables/actions",
+				url.QueryEscape(org.Login),
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+	return append(inventoryResults, results...), nil
+}
+
+// checkVariables lists the organization Actions variables and reports variables that look like credentials or that
+// are selected for repositories that no longer need them. Variables are not secret by design, so their visibility is
+// only listed in the inventory.
+func (r rule) checkVariables(org *github.Organization) (inventory []string, results []hubcheck.RuleResult, err error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/variables/actions",
+		url.QueryEscape(org.Login),
+	)
+	variables, err := org.ListVariables()
+	if err != nil {
+		if github.IsNotFound(err) {
+			// Variables are not available on older GitHub Enterprise Server versions.
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	for _, variable := range variables {
+		name := fmt.Sprintf("Actions variable %s", variable.Name)
+		inventory = append(inventory, fmt.Sprintf(
+			"- `%s` (visible to %s repositories, updated %s)",
+			variable.Name,
+			variable.Visibility,
+			variable.UpdatedAt.Format("2006-01-02"),
+		))
+
+		if looksLikeCredential(variable.Name) {
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf("The %s may contain a credential", name),
+				Description: fmt.Sprintf(
+					"%s\n\nThe name of the variable suggests that it holds a credential. The value of a variable can be read by anyone who can use it, store credentials as secrets instead.",
+					r.Description(),
+				),
+				FixURL: fixURL,
+				DocURL: r.DocURL(),
+			})
+		}
+
+		if variable.Visibility == github.SecretVisibilitySelected {
+			selected, err := org.ListVariableRepositories(variable.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			results = append(results, r.checkSelectedRepositories(org, selected, name, fixURL)...)
+		}
+	}
+	return inventory, results, nil
+}
+
+// looksLikeCredential returns true if the name of a variable ends in one of the credentialSuffixes.
+func looksLikeCredential(name string) bool {
+	segments := strings.Split(strings.ToUpper(name), "_")
+	for _, suffix := range credentialSuffixes {
+		if len(segments) < len(suffix) {
+			continue
+		}
+		tail := segments[len(segments)-len(suffix):]
+		matches := true
+		for i, segment := range suffix {
+			if tail[i] != segment {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSelectedRepositories reports secrets and variables that are selected for archived repositories. GitHub removes
+// deleted repositories from the selection, so a secret or variable without any selected repository is left over from
+// deleted ones.
+func (r rule) checkSelectedRepositories(
+	org *github.Organization,
+	repos []*github.Repository,
+	name string,
+	fixURL string,
+) []hubcheck.RuleResult {
+	if len(repos) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf("The %s is not selected for any repository", name),
+				Description: fmt.Sprintf(
+					"%s\n\nThe repositories the %s was selected for have been deleted or transferred. Remove it if it is no longer needed.",
+					r.Description(),
+					name,
+				),
+				FixURL: fixURL,
+				DocURL: r.DocURL(),
+			},
+		}
+	}
+	var archived []string
+	for _, repo := range repos {
+		if repo.Archived {
+			archived = append(archived, fmt.Sprintf("- %s/%s", org.Login, repo.Name))
+		}
+	}
+	if len(archived) == 0 {
+		return nil
+	}
+	sort.Strings(archived)
+	return []hubcheck.RuleResult{
+		{
+			Level: hublog.Warning,
+			Title: fmt.Sprintf("The %s is selected for archived repositories", name),
+			Description: fmt.Sprintf(
+				"%s\n\nThe %s is selected for the following archived repositories:\n\n%s",
+				r.Description(),
+				name,
+				strings.Join(archived, "\n"),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		},
+	}
+}
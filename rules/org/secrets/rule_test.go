@@ -0,0 +1,29 @@
+package secrets
+
+import "testing"
+
+func TestLooksLikeCredential(t *testing.T) {
+	tests := map[string]bool{
+		"NPM_TOKEN":               true,
+		"github_token":            true,
+		"DB_PASSWORD":             true,
+		"CLIENT_SECRET":           true,
+		"AWS_SECRET_ACCESS_KEY":   true,
+		"SIGNING_PRIVATE_KEY":     true,
+		"MAPS_API_KEY":            true,
+		"DEPLOY_CREDENTIALS":      true,
+		"TOKEN":                   true,
+		"TOKEN_URL":               false,
+		"SECRET_SCANNING_ENABLED": false,
+		"CREDENTIAL_HELPER":       false,
+		"PUBLIC_KEY":              false,
+		"KEY":                     false,
+		"TOKENIZER_MODEL":         false,
+		"REGION":                  false,
+	}
+	for name, expected := range tests {
+		if actual := looksLikeCredential(name); actual != expected {
+			t.Errorf("looksLikeCredential(%q) = %v, expected %v", name, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package securitydefaults
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Security features for new repositories"
+}
+
+func (r rule) Description() string {
+	return "Security features such as Dependabot alerts and secret scanning should be enabled automatically for new repositories. Otherwise repositories created after the last check start without them until someone notices."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/keeping-your-organization-secure/managing-security-settings-for-your-organization/managing-security-and-analysis-settings-for-your-organization"
+}
+
+func (r rule) ID() string {
+	return "security-defaults"
+}
+
+// feature is a security feature that can be enabled for new repositories.
+type feature struct {
+	name    string
+	enabled *bool
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/security_analysis",
+		url.QueryEscape(org.Login),
+	)
+	features := []feature{
+		{"Dependency graph", org.DependencyGraphEnabledForNewRepositories},
+		{"Dependabot alerts", org.DependabotAlertsEnabledForNewRepositories},
+		{"Dependabot security updates", org.DependabotSecurityUpdatesEnabledForNewRepositories},
+		{"Secret scanning", org.SecretScanningEnabledForNewRepositories},
+		{"Secret scanning push protection", org.SecretScanningPushProtectionEnabledForNewRepositories},
+	}
+
+	var results []hubcheck.RuleResult
+	for _, f := range features {
+		// The defaults are only returned to organization admins.
+		if f.enabled == nil {
+			continue
+		}
+		if *f.enabled {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Notice,
+				Title:       fmt.Sprintf("%s is enabled for new repositories", f.name),
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			})
+			continue
+		}
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Title:       fmt.Sprintf("%s is not enabled for new repositories", f.name),
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	if len(results) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Warning,
+				Title:       "OrgRule execution failed",
+				Description: "Are you an admin?",
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+	return results, nil
+}
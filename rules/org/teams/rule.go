@@ -0,0 +1,255 @@
+package teams
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Policy describes the expected access of teams.
+type Policy struct {
+	// MaxAdminRepos is the maximum number of repositories a team should administer. If it is 0, the number is not
+	// checked.
+	MaxAdminRepos int `json:"max_admin_repos"`
+}
+
+// DefaultPolicy returns the policy used if the configuration doesn't specify one.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAdminRepos: 10,
+	}
+}
+
+func New(policy Policy) hubcheck.OrgRule {
+	return &rule{
+		policy: policy,
+	}
+}
+
+type rule struct {
+	policy Policy
+}
+
+func (r rule) Name() string {
+	return "Team permissions"
+}
+
+func (r rule) Description() string {
+	return "Teams should have the least access they need. Teams administering many repositories, teams without maintainers and empty teams that still hold permissions are easily forgotten when access is reviewed. Nested teams inherit the access of their parent, so they should not grant more access than the parent team itself."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/organizing-members-into-teams/about-teams"
+}
+
+func (r rule) ID() string {
+	return "team-permissions"
+}
+
+// teamAccess is the collected data of a single team.
+type teamAccess struct {
+	team        *github.Team
+	repos       []*github.TeamRepository
+	members     int
+	maintainers int
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf("https://github.com/orgs/%s/teams", url.PathEscape(org.Login))
+	teams, err := org.ListTeams()
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Notice,
+				Title:       "No teams",
+				Description: r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	accesses := map[string]*teamAccess{}
+	var slugs []string
+	for _, team := range teams {
+		repos, err := org.ListTeamRepositories(team.Slug)
+		if err != nil {
+			return nil, err
+		}
+		members, err := org.ListTeamMembers(team.Slug, github.TeamRoleAll)
+		if err != nil {
+			return nil, err
+		}
+		maintainers, err := org.ListTeamMembers(team.Slug, github.TeamRoleMaintainer)
+		if err != nil {
+			return nil, err
+		}
+		accesses[team.Slug] = &teamAccess{
+			team:        team,
+			repos:       repos,
+			members:     len(members),
+			maintainers: len(maintainers),
+		}
+		slugs = append(slugs, team.Slug)
+	}
+	sort.Strings(slugs)
+
+	matrix, err := r.matrix(accesses)
+	if err != nil {
+		return nil, err
+	}
+	results := []hubcheck.RuleResult{
+		{
+			Level: hublog.Info,
+			Title: fmt.Sprintf("Repository permissions of %d teams", len(teams)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe teams have the following roles on your repositories:\n\n```json\n%s\n```",
+				r.Description(),
+				matrix,
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		},
+	}
+
+	for _, slug := range slugs {
+		access := accesses[slug]
+		teamFixURL := fmt.Sprintf(
+			"https://github.com/orgs/%s/teams/%s",
+			url.PathEscape(org.Login),
+			url.PathEscape(slug),
+		)
+		var adminRepos []string
+		for _, repo := range access.repos {
+			if repo.Permissions.Admin {
+				adminRepos = append(adminRepos, repo.Name)
+			}
+		}
+		if r.policy.MaxAdminRepos > 0 && len(adminRepos) > r.policy.MaxAdminRepos {
+			sort.Strings(adminRepos)
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf(
+					"Team %s administers %d repositories, at most %d are allowed",
+					access.team.Name,
+					len(adminRepos),
+					r.policy.MaxAdminRepos,
+				),
+				Description: fmt.Sprintf(
+					"%s\n\nThe team has admin access to the following repositories: %s",
+					r.Description(),
+					strings.Join(adminRepos, ", "),
+				),
+				FixURL: teamFixURL,
+				DocURL: r.DocURL(),
+			})
+		}
+		if access.members == 0 && len(access.repos) > 0 {
+			results = append(results, hubcheck.RuleResult{
+				Level: hublog.Warning,
+				Title: fmt.Sprintf(
+					"Team %s has no members, but has access to %d repositories",
+					access.team.Name,
+					len(access.repos),
+				),
+				Description: r.Description(),
+				FixURL:      teamFixURL,
+				DocURL:      r.DocURL(),
+			})
+		} else if access.members > 0 && access.maintainers == 0 {
+			results = append(results, hubcheck.RuleResult{
+				Level:       hublog.Warning,
+				Title:       fmt.Sprintf("Team %s (%s) has no maintainers", access.team.Name, access.team.Privacy),
+				Description: r.Description(),
+				FixURL:      teamFixURL,
+				DocURL:      r.DocURL(),
+			})
+		}
+		if access.team.Parent != nil {
+			if parent, ok := accesses[access.team.Parent.Slug]; ok {
+				if escalations := escalations(parent, access); len(escalations) > 0 {
+					results = append(results, hubcheck.RuleResult{
+						Level: hublog.Warning,
+						Title: fmt.Sprintf(
+							"Nested team %s has more access than its parent team %s",
+							access.team.Name,
+							parent.team.Name,
+						),
+						Description: fmt.Sprintf(
+							"%s\n\nThe team has a higher role than its parent on the following repositories:\n\n%s",
+							r.Description(),
+							strings.Join(escalations, "\n"),
+						),
+						FixURL: teamFixURL,
+						DocURL: r.DocURL(),
+					})
+				}
+			}
+		}
+	}
+	return results, nil
+}
+
+// matrix returns the repository → team → role matrix as indented JSON.
+func (r rule) matrix(accesses map[string]*teamAccess) (string, error) {
+	matrix := map[string]map[string]string{}
+	for slug, access := range accesses {
+		for _, repo := range access.repos {
+			if _, ok := matrix[repo.Name]; !ok {
+				matrix[repo.Name] = map[string]string{}
+			}
+			matrix[repo.Name][slug] = repo.Role()
+		}
+	}
+	data, err := json.MarshalIndent(matrix, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode team permission matrix (%w)", err)
+	}
+	return string(data), nil
+}
+
+// escalations lists the repositories on which the child team has a higher role than the parent team. Repositories the
+// parent team has no access to are not escalations, nested teams are commonly given access of their own.
+func escalations(parent *teamAccess, child *teamAccess) []string {
+	parentRepos := map[string]*github.TeamRepository{}
+	for _, repo := range parent.repos {
+		parentRepos[repo.Name] = repo
+	}
+	var result []string
+	for _, repo := range child.repos {
+		parentRepo, ok := parentRepos[repo.Name]
+		if !ok || rank(repo.Permissions) <= rank(parentRepo.Permissions) {
+			continue
+		}
+		result = append(result, fmt.Sprintf("- %s: %s (parent: %s)", repo.Name, repo.Role(), parentRepo.Role()))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// rank orders permissions from read to admin.
+func rank(permissions github.RepoPermissions) int {
+	switch {
+	case permissions.Admin:
+		return 5
+	case permissions.Maintain:
+		return 4
+	case permissions.Push:
+		return 3
+	case permissions.Triage:
+		return 2
+	case permissions.Pull:
+		return 1
+	default:
+		return 0
+	}
+}
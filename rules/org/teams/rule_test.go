@@ -0,0 +1,68 @@
+package teams
+
+import (
+	"reflect"
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+var (
+	read     = github.RepoPermissions{Pull: true}
+	write    = github.RepoPermissions{Pull: true, Triage: true, Push: true}
+	maintain = github.RepoPermissions{Pull: true, Triage: true, Push: true, Maintain: true}
+	admin    = github.RepoPermissions{Pull: true, Triage: true, Push: true, Maintain: true, Admin: true}
+)
+
+func repos(permissions map[string]github.RepoPermissions) *teamAccess {
+	access := &teamAccess{}
+	for name, p := range permissions {
+		access.repos = append(access.repos, &github.TeamRepository{Name: name, Permissions: p})
+	}
+	return access
+}
+
+func TestEscalations(t *testing.T) {
+	tests := []struct {
+		name     string
+		parent   map[string]github.RepoPermissions
+		child    map[string]github.RepoPermissions
+		expected []string
+	}{
+		{
+			name:   "same role",
+			parent: map[string]github.RepoPermissions{"app": write},
+			child:  map[string]github.RepoPermissions{"app": write},
+		},
+		{
+			name:   "lower role",
+			parent: map[string]github.RepoPermissions{"app": admin},
+			child:  map[string]github.RepoPermissions{"app": read},
+		},
+		{
+			name:     "higher role",
+			parent:   map[string]github.RepoPermissions{"app": read, "docs": write},
+			child:    map[string]github.RepoPermissions{"app": maintain, "docs": admin},
+			expected: []string{"- app: maintain (parent: read)", "- docs: admin (parent: write)"},
+		},
+		{
+			name:   "parent without access",
+			parent: map[string]github.RepoPermissions{"app": read},
+			child:  map[string]github.RepoPermissions{"infra": admin},
+		},
+		{
+			name:     "triage",
+			parent:   map[string]github.RepoPermissions{"app": read},
+			child:    map[string]github.RepoPermissions{"app": {Pull: true, Triage: true}},
+			expected: []string{"- app: triage (parent: read)"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := escalations(repos(tc.parent), repos(tc.child))
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
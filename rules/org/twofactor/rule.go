@@ -3,6 +3,8 @@ package twofactor
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
@@ -61,7 +63,7 @@ func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
 			},
 		}, nil
 	}
-	return []hubcheck.RuleResult{
+	results := []hubcheck.RuleResult{
 		{
 			Level:       hublog.Error,
 			Title:       "Two-factor authentication enforcement is not enabled",
@@ -72,5 +74,64 @@ func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
 			),
 			DocURL: r.DocURL(),
 		},
-	}, nil
+	}
+	return append(results, r.lockedOut(org)), nil
+}
+
+// lockedOut lists the members and outside collaborators who would be removed from the organization if two-factor
+// authentication was enforced.
+func (r rule) lockedOut(org *github.Organization) hubcheck.RuleResult {
+	members, err := org.ListMembers(github.MemberFilter2FADisabled)
+	if err != nil {
+		return r.lockedOutFailed(org, err)
+	}
+	collaborators, err := org.ListOutsideCollaborators(github.MemberFilter2FADisabled)
+	if err != nil {
+		return r.lockedOutFailed(org, err)
+	}
+	if len(members) == 0 && len(collaborators) == 0 {
+		return hubcheck.RuleResult{
+			Level:       hublog.Info,
+			Title:       "All members have two-factor authentication enabled",
+			Description: "Nobody would lose access to the organization if you enforced two-factor authentication.",
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/security",
+				url.QueryEscape(org.Login),
+			),
+			DocURL: r.DocURL(),
+		}
+	}
+
+	var lines []string
+	for _, member := range members {
+		lines = append(lines, fmt.Sprintf("- `%s` (member)", member.Login))
+	}
+	for _, collaborator := range collaborators {
+		lines = append(lines, fmt.Sprintf("- `%s` (outside collaborator)", collaborator.Login))
+	}
+	sort.Strings(lines)
+	return hubcheck.RuleResult{
+		Level: hublog.Warning,
+		Title: fmt.Sprintf(
+			"%d members and %d outside collaborators don't have two-factor authentication enabled",
+			len(members),
+			len(collaborators),
+		),
+		Description: fmt.Sprintf(
+			"These users would be removed from the organization if you enforced two-factor authentication. Ask them to enable it before turning on enforcement:\n\n%s",
+			strings.Join(lines, "\n"),
+		),
+		FixURL: fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login)),
+		DocURL: r.DocURL(),
+	}
+}
+
+func (r rule) lockedOutFailed(org *github.Organization, err error) hubcheck.RuleResult {
+	return hubcheck.RuleResult{
+		Level:       hublog.Warning,
+		Title:       "Failed to list members without two-factor authentication",
+		Description: fmt.Sprintf("Only organization owners can list members without two-factor authentication. (%v)", err),
+		FixURL:      fmt.Sprintf("https://github.com/orgs/%s/people", url.PathEscape(org.Login)),
+		DocURL:      r.DocURL(),
+	}
 }
@@ -0,0 +1,81 @@
+package webhooks
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/webhookcheck"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) Name() string {
+	return "Organization webhooks"
+}
+
+func (r rule) Description() string {
+	return "Webhooks send data about your organization to other systems. They should only use HTTPS with certificate verification, and should have a secret so the receiver can verify that the payload comes from GitHub. Webhooks whose deliveries keep failing should be fixed or removed."
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/webhooks/using-webhooks/best-practices-for-using-webhooks"
+}
+
+func (r rule) ID() string {
+	return "organization-webhooks"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	hooks, err := org.ListHooks()
+	if err != nil {
+		return nil, err
+	}
+	var results []hubcheck.RuleResult
+	for _, hook := range hooks {
+		deliveries, err := org.ListRecentHookDeliveries(hook.Id)
+		if err != nil {
+			return nil, err
+		}
+		problems := webhookcheck.Problems(hook, deliveries)
+		if len(problems) == 0 {
+			continue
+		}
+		results = append(results, hubcheck.RuleResult{
+			Level: hublog.Error,
+			Title: fmt.Sprintf("Webhook %s is insecure or broken", webhookcheck.DisplayURL(hook)),
+			Description: fmt.Sprintf(
+				"%s\n\nThe webhook has the following problems:\n\n- %s",
+				r.Description(),
+				strings.Join(problems, "\n- "),
+			),
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/hooks/%d",
+				url.QueryEscape(org.Login),
+				hook.Id,
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+	if len(results) == 0 {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       fmt.Sprintf("%d organization webhooks, no problems found", len(hooks)),
+			Description: r.Description(),
+			FixURL: fmt.Sprintf(
+				"https://github.com/organizations/%s/settings/hooks",
+				url.QueryEscape(org.Login),
+			),
+			DocURL: r.DocURL(),
+		})
+	}
+	return results, nil
+}
@@ -7,6 +7,7 @@ import (
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/workflowapprovalcheck"
 )
 
 func New() hubcheck.OrgRule {
@@ -21,11 +22,11 @@ func (r rule) DocURL() string {
 }
 
 func (r rule) Name() string {
-	return "Require workflow approvals (manual)"
+	return "Require workflow approvals"
 }
 
 func (r rule) Description() string {
-	return "Workflow approvals cannot be checked automatically, please check them manually. When a pull request is submitted from a fork, GitHub actions should not be run automatically or you risk exposing sensitive credentials to untrusted code. You should change your settings to require approvals from a project maintainer in order to run workflows."
+	return "When a pull request is submitted from a fork, GitHub actions should not be run automatically or you risk exposing sensitive credentials to untrusted code. You should change your settings to require approvals from a project maintainer in order to run workflows for all outside contributors."
 }
 
 func (r rule) ID() string {
@@ -33,16 +34,33 @@ func (r rule) ID() string {
 }
 
 func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/actions",
+		url.QueryEscape(org.Login),
+	)
+	approval, err := org.GetForkPRContributorApproval()
+	if err != nil {
+		if !github.IsNotFound(err) {
+			return nil, err
+		}
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Info,
+				Title:       "Workflow approval requirements (manual check)",
+				Description: "Workflow approvals cannot be checked automatically on this GitHub version, please check them manually. " + r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+	level, title := workflowapprovalcheck.Evaluate(approval)
 	return []hubcheck.RuleResult{
 		{
-			Level:       hublog.Info,
-			Title:       "Workflow approval requirements",
+			Level:       level,
+			Title:       title,
 			Description: r.Description(),
-			FixURL: fmt.Sprintf(
-				"https://github.com/organizations/%s/settings/actions",
-				url.QueryEscape(org.Login),
-			),
-			DocURL: r.DocURL(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
 		},
 	}, nil
 }
@@ -0,0 +1,74 @@
+package workflowpermissions
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.OrgRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/organizations/managing-organization-settings/disabling-or-limiting-github-actions-for-your-organization#setting-the-permissions-of-the-github_token-for-your-organization"
+}
+
+func (r rule) Name() string {
+	return "Default workflow permissions on the organization"
+}
+
+func (r rule) Description() string {
+	return "The GITHUB_TOKEN should only have read permissions by default, workflows that need more can request them explicitly. GitHub Actions should not be able to approve pull requests, otherwise a workflow can bypass required reviews."
+}
+
+func (r rule) ID() string {
+	return "github-actions-workflow-permissions"
+}
+
+func (r rule) Run(org *github.Organization) ([]hubcheck.RuleResult, error) {
+	permissions, err := org.GetWorkflowPermissions()
+	if err != nil {
+		return nil, err
+	}
+	fixURL := fmt.Sprintf(
+		"https://github.com/organizations/%s/settings/actions",
+		url.QueryEscape(org.Login),
+	)
+
+	var results []hubcheck.RuleResult
+	if permissions.DefaultWorkflowPermissions == "write" {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Title:       "The GITHUB_TOKEN has write permissions by default",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	if permissions.CanApprovePullRequestReviews {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Title:       "GitHub Actions can approve pull requests",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	if len(results) == 0 {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Title:       "The GITHUB_TOKEN is read-only by default",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	return results, nil
+}
@@ -3,17 +3,22 @@ package actionspermissions
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
 	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/selectedactions"
 )
 
-func New() hubcheck.RepoRule {
-	return &rule{}
+func New(policy selectedactions.Policy) hubcheck.RepoRule {
+	return &rule{
+		policy: policy,
+	}
 }
 
 type rule struct {
+	policy selectedactions.Policy
 }
 
 func (r rule) DocURL() string {
@@ -32,7 +37,7 @@ func (r rule) ID() string {
 	return "github-actions-repo-permissions"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
 	actionsPermissions, err := repo.GetActionsPermissions()
 	if err != nil {
 		return nil, err
@@ -46,7 +51,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: r.Description(),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/settings/actions",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 			),
 			DocURL: r.DocURL(),
@@ -60,7 +65,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: r.Description(),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/settings/actions",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 			),
 			DocURL: r.DocURL(),
@@ -72,7 +77,32 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 	}
 	switch actionsPermissions.AllowedActions {
 	case "selected":
-		return okResult, nil
+		selected, err := repo.GetSelectedActions()
+		if err != nil {
+			return nil, err
+		}
+		violations := r.policy.Evaluate(selected)
+		if len(violations) == 0 {
+			return okResult, nil
+		}
+		var lines []string
+		for _, violation := range violations {
+			lines = append(lines, "- "+violation.String())
+		}
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Error,
+				Repository:  repo.Name,
+				Title:       "The allowed GitHub Actions violate your policy",
+				Description: r.Description() + "\n\nThe following entries of the allowlist violate your policy:\n\n" + strings.Join(lines, "\n"),
+				FixURL: fmt.Sprintf(
+					"https://github.com/%s/%s/settings/actions",
+					url.QueryEscape(owner.GetLogin()),
+					url.QueryEscape(repo.Name),
+				),
+				DocURL: r.DocURL(),
+			},
+		}, nil
 	case "local_only":
 		return okResult, nil
 	default:
@@ -42,7 +42,7 @@ func (r rule) ID() string {
 	return "containing"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
 	if r.term == "" {
 		return nil, nil
 	}
@@ -67,7 +67,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Level:       hublog.Debug,
 				Repository:  repo.Name,
 				Title:       "File too large for analysis",
-				Description: fmt.Sprintf("File %s is too large for content analysis, skipping..."),
+				Description: fmt.Sprintf("File %s is too large for content analysis, skipping...", f.Path),
 			})
 			continue
 		}
@@ -83,7 +83,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Level:       hublog.Debug,
 				Repository:  repo.Name,
 				Title:       "File matches ignore pattern",
-				Description: fmt.Sprintf("File %s matches ignore pattern, skipping analysis..."),
+				Description: fmt.Sprintf("File %s matches ignore pattern, skipping analysis...", f.Path),
 			})
 			continue
 		}
@@ -105,7 +105,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Description: fmt.Sprintf("This file contains the search term '%s'.", r.term),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/edit/%s/%s",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 					f.Path,
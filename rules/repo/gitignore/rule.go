@@ -32,18 +32,18 @@ func (r rule) ID() string {
 	return "gitignore"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
-	repoContents, err := repo.ListContents()
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+	metadata, err := repo.GetMetadata()
 	if err != nil {
 		return []hubcheck.RuleResult{
 			{
 				Level:       hublog.Warning,
 				Repository:  repo.Name,
 				Title:       "Cannot check .gitignore",
-				Description: fmt.Sprintf("Failed to list repository contents. (%v)", err),
+				Description: fmt.Sprintf("Failed to fetch repository metadata. (%v)", err),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/new/%s?filename=.gitignore",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 				),
@@ -52,15 +52,11 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 		}, nil
 	}
 
-	found := ""
-	for _, f := range repoContents {
-		if f.Path == "" && f.Name == ".gitignore" {
-			found = f.Name
-			break
-		}
-	}
+	found := metadata.FindFile([]string{""}, func(name string) bool {
+		return name == ".gitignore"
+	})
 
-	if found != "" {
+	if found != nil {
 		return []hubcheck.RuleResult{
 			{
 				Level:       hublog.Notice,
@@ -80,7 +76,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: fmt.Sprintf("The repository has no .gitignore file."),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/new/%s?filename=.gitignore",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 				url.QueryEscape(repo.DefaultBranch),
 			),
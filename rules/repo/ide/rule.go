@@ -33,7 +33,7 @@ func (r rule) ID() string {
 	return "ide"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
 	repoContents, err := repo.ListContents()
 	if err != nil {
 		return []hubcheck.RuleResult{
@@ -61,7 +61,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/delete/%s/%s",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 					f.Path,
@@ -32,7 +32,7 @@ func (r rule) ID() string {
 	return "public-repo-license"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
 	if repo.License != nil {
 		return []hubcheck.RuleResult{
 			{
@@ -42,7 +42,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Description: fmt.Sprintf("This repository is licensed under the %s.", repo.License.Name),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/community/license/new",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 				),
 				DocURL: r.DocURL(),
@@ -59,7 +59,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Description: fmt.Sprintf("This repository does not have a license file, but this repository is not public. Consider adding a license."),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/community/license/new",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 				),
 				DocURL: r.DocURL(),
@@ -75,7 +75,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: fmt.Sprintf("This repository does not have a license file."),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/community/license/new",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 			),
 			DocURL: r.DocURL(),
@@ -3,19 +3,28 @@ package repo
 import (
 	"github.com/gobwas/glob"
 	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/config"
 	"go.debugged.it/hubcheck/rules/repo/actionspermissions"
 	"go.debugged.it/hubcheck/rules/repo/containing"
 	"go.debugged.it/hubcheck/rules/repo/gitignore"
 	"go.debugged.it/hubcheck/rules/repo/ide"
 	"go.debugged.it/hubcheck/rules/repo/license"
 	"go.debugged.it/hubcheck/rules/repo/readme"
+	"go.debugged.it/hubcheck/rules/repo/selfhostedrunners"
 	"go.debugged.it/hubcheck/rules/repo/vulnalerts"
+	"go.debugged.it/hubcheck/rules/repo/webhooks"
+	"go.debugged.it/hubcheck/rules/repo/workflowapprovals"
+	"go.debugged.it/hubcheck/rules/repo/workflowpermissions"
 )
 
-func New(ignoreFilesList []glob.Glob, containingTerm string) []hubcheck.RepoRule {
+func New(cfg config.Config, ignoreFilesList []glob.Glob, containingTerm string) []hubcheck.RepoRule {
 	return []hubcheck.RepoRule{
-		actionspermissions.New(),
+		actionspermissions.New(cfg.SelectedActions),
+		workflowpermissions.New(),
+		workflowapprovals.New(),
+		selfhostedrunners.New(),
 		vulnalerts.New(),
+		webhooks.New(),
 		license.New(),
 		readme.New(),
 		gitignore.New(),
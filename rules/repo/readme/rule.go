@@ -3,7 +3,6 @@ package readme
 import (
 	"fmt"
 	"net/url"
-	"strings"
 
 	"go.debugged.it/hubcheck"
 	"go.debugged.it/hubcheck/github"
@@ -33,18 +32,18 @@ func (r rule) ID() string {
 	return "readme"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
-	repoContents, err := repo.ListContents()
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+	metadata, err := repo.GetMetadata()
 	if err != nil {
 		return []hubcheck.RuleResult{
 			{
 				Level:       hublog.Warning,
 				Repository:  repo.Name,
 				Title:       "Cannot check README",
-				Description: fmt.Sprintf("Failed to list repository contents. (%v)", err),
+				Description: fmt.Sprintf("Failed to fetch repository metadata. (%v)", err),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/new/%s?readme=1",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 				),
@@ -53,14 +52,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 		}, nil
 	}
 
-	var found *github.RepoDirEntry
-	for _, f := range repoContents {
-		if f.Path == f.Name && strings.HasPrefix(f.Name, "README") {
-			found = &f
-			break
-		}
-	}
-
+	found := metadata.Readme()
 	if found == nil {
 		return []hubcheck.RuleResult{
 			{
@@ -70,7 +62,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				Description: fmt.Sprintf("The repository has no README file."),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/new/%s?readme=1",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 				),
@@ -91,7 +83,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 				),
 				FixURL: fmt.Sprintf(
 					"https://github.com/%s/%s/edit/%s/%s",
-					url.QueryEscape(org.Login),
+					url.QueryEscape(owner.GetLogin()),
 					url.QueryEscape(repo.Name),
 					url.QueryEscape(repo.DefaultBranch),
 					found.Path,
@@ -106,7 +98,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Level:       hublog.Notice,
 			Repository:  repo.Name,
 			Title:       "Repository has a README",
-			Description: fmt.Sprintf("The repository has a README file named %s.", found),
+			Description: fmt.Sprintf("The repository has a README file named %s.", found.Path),
 			DocURL:      r.DocURL(),
 		},
 	}, nil
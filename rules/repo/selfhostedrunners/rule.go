@@ -0,0 +1,232 @@
+package selfhostedrunners
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.RepoRule {
+	return &rule{
+		runners: map[string]*selfHostedRunners{},
+	}
+}
+
+type rule struct {
+	// runners holds the self-hosted runners of each owner, so they are only fetched and reported once.
+	runners map[string]*selfHostedRunners
+}
+
+// selfHostedRunners contains the labels and runner group names jobs can use to target the self-hosted runners of an
+// organization.
+type selfHostedRunners struct {
+	labels map[string]bool
+	groups map[string]bool
+	err    error
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/actions/hosting-your-own-runners/managing-self-hosted-runners/about-self-hosted-runners#self-hosted-runner-security"
+}
+
+func (r rule) Name() string {
+	return "No self-hosted runners on public repositories"
+}
+
+func (r rule) Description() string {
+	return "Self-hosted runners should not be used with public repositories, because forks of the repository can run dangerous code on them by opening a pull request. Use GitHub-hosted runners for public repositories instead."
+}
+
+func (r rule) ID() string {
+	return "self-hosted-runners-public-repos"
+}
+
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+	if repo.Visibility != "public" {
+		return nil, nil
+	}
+	metadata, err := repo.GetMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []hubcheck.RuleResult
+	runners := &selfHostedRunners{}
+	if org, ok := owner.(*github.Organization); ok {
+		var fetched bool
+		runners, fetched = r.runners[org.Login]
+		if !fetched {
+			runners = listSelfHostedRunners(org)
+			r.runners[org.Login] = runners
+			if runners.err != nil {
+				results = append(results, hubcheck.RuleResult{
+					Level: hublog.Warning,
+					Title: "The self-hosted runners of the organization could not be listed",
+					Description: fmt.Sprintf(
+						"Only workflows using the self-hosted label are detected in the repositories of %s, custom labels and runner groups are not. (%v)",
+						org.Login,
+						runners.err,
+					),
+					DocURL: r.DocURL(),
+				})
+			}
+		}
+	}
+
+	var workflows []string
+	for _, f := range metadata.Workflows() {
+		contents, err := repo.GetContents(f.Path)
+		if err != nil {
+			return nil, err
+		}
+		if runners.targetedBy(string(contents)) {
+			workflows = append(workflows, "- "+f.Path)
+		}
+	}
+	if len(workflows) == 0 {
+		return results, nil
+	}
+	return append(results, hubcheck.RuleResult{
+		Level:      hublog.Error,
+		Repository: repo.Name,
+		Title:      "Workflows run on self-hosted runners",
+		Description: fmt.Sprintf(
+			"%s\n\nThe following workflows target self-hosted runners:\n\n%s",
+			r.Description(),
+			strings.Join(workflows, "\n"),
+		),
+		FixURL: fmt.Sprintf(
+			"https://github.com/%s/%s/tree/HEAD/.github/workflows",
+			url.PathEscape(owner.GetLogin()),
+			url.PathEscape(repo.Name),
+		),
+		DocURL: r.DocURL(),
+	}), nil
+}
+
+// listSelfHostedRunners collects the labels and group names of the self-hosted runners of the organization. Runners
+// registered on a repository are not included.
+func listSelfHostedRunners(org *github.Organization) *selfHostedRunners {
+	result := &selfHostedRunners{
+		labels: map[string]bool{},
+		groups: map[string]bool{},
+	}
+	groups, err := org.ListRunnerGroups()
+	if err != nil {
+		if !github.IsNotFound(err) {
+			result.err = err
+		}
+		return result
+	}
+	for _, group := range groups {
+		runners, err := org.ListRunnerGroupRunners(group.Id)
+		if err != nil {
+			result.err = err
+			return result
+		}
+		if len(runners) > 0 {
+			result.groups[strings.ToLower(group.Name)] = true
+		}
+		for _, runner := range runners {
+			for _, label := range runner.Labels {
+				result.labels[strings.ToLower(label.Name)] = true
+			}
+		}
+	}
+	return result
+}
+
+var matrixRe = regexp.MustCompile(`\$\{\{\s*matrix\.([\w-]+)\s*}}`)
+var expressionRe = regexp.MustCompile(`\$\{\{.*?}}`)
+
+// targetedBy returns true if a runs-on key of the workflow selects a self-hosted runner: either by the self-hosted
+// label, by a label of one of the self-hosted runners, or by the name of a runner group containing self-hosted runners.
+// Matrix variables used in runs-on are resolved by looking up the values of the matrix key anywhere in the workflow,
+// other expressions are ignored.
+func (s *selfHostedRunners) targetedBy(workflow string) bool {
+	for _, value := range keyValues(workflow, "runs-on") {
+		for _, match := range matrixRe.FindAllStringSubmatch(value, -1) {
+			for _, matrixValue := range keyValues(workflow, match[1]) {
+				if s.matches(matrixValue) {
+					return true
+				}
+			}
+		}
+		if s.matches(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if any label or group in a runs-on value selects a self-hosted runner.
+func (s *selfHostedRunners) matches(value string) bool {
+	value = expressionRe.ReplaceAllString(value, "")
+	value = strings.NewReplacer("[", ",", "]", ",", "{", ",", "}", ",").Replace(value)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(item), "- "))
+		key, label, isMapping := strings.Cut(item, ":")
+		if !isMapping {
+			label = key
+			key = "labels"
+		}
+		label = strings.ToLower(strings.Trim(strings.TrimSpace(label), `"'`))
+		if label == "" {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "group":
+			if s.groups[label] {
+				return true
+			}
+		case "labels":
+			if label == "self-hosted" || s.labels[label] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// keyValues returns the values of all occurrences of a key in a workflow. The workflow is scanned line by line so the
+// inline, flow and block forms are recognized without a YAML parser. A value in block form is returned as one line per
+// entry.
+func keyValues(workflow string, key string) []string {
+	var result []string
+	inBlock := false
+	blockIndent := 0
+	for _, line := range strings.Split(workflow, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if inBlock {
+			if indent > blockIndent {
+				result = append(result, trimmed)
+				continue
+			}
+			inBlock = false
+		}
+		trimmed = strings.TrimPrefix(trimmed, "- ")
+		if !strings.HasPrefix(trimmed, key+":") {
+			continue
+		}
+		value := strings.TrimSpace(trimmed[len(key)+1:])
+		if value == "" {
+			inBlock = true
+			blockIndent = indent
+			continue
+		}
+		result = append(result, value)
+	}
+	return result
+}
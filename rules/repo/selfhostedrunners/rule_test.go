@@ -0,0 +1,66 @@
+package selfhostedrunners
+
+import (
+	"testing"
+)
+
+func TestTargetedBy(t *testing.T) {
+	runners := &selfHostedRunners{
+		labels: map[string]bool{"self-hosted": true, "linux": true, "gpu": true},
+		groups: map[string]bool{"build-farm": true},
+	}
+	tests := []struct {
+		name     string
+		workflow string
+		expected bool
+	}{
+		{"GitHub-hosted", "jobs:\n  build:\n    runs-on: ubuntu-latest\n", false},
+		{"inline", "jobs:\n  build:\n    runs-on: self-hosted\n", true},
+		{"quoted", "jobs:\n  build:\n    runs-on: 'self-hosted'\n", true},
+		{"comment", "jobs:\n  build:\n    runs-on: ubuntu-latest # not self-hosted\n", false},
+		{"label array", "jobs:\n  build:\n    runs-on: [linux, gpu]\n", true},
+		{"unknown label array", "jobs:\n  build:\n    runs-on: [windows, x64]\n", false},
+		{"block sequence", "jobs:\n  build:\n    runs-on:\n      - linux\n      - gpu\n    steps:\n      - run: echo\n", true},
+		{
+			"block sequence followed by other keys",
+			"jobs:\n  build:\n    runs-on:\n      - ubuntu-latest\n    steps:\n      - run: echo self-hosted\n",
+			false,
+		},
+		{"group", "jobs:\n  build:\n    runs-on:\n      group: build-farm\n", true},
+		{"flow group", "jobs:\n  build:\n    runs-on: { group: Build-Farm, labels: [x64] }\n", true},
+		{"unknown group", "jobs:\n  build:\n    runs-on:\n      group: larger-runners\n      labels: ubuntu-latest-16-cores\n", false},
+		{
+			"matrix",
+			"jobs:\n  build:\n    strategy:\n      matrix:\n        os: [ubuntu-latest, self-hosted]\n    runs-on: ${{ matrix.os }}\n",
+			true,
+		},
+		{
+			"matrix include",
+			"jobs:\n  build:\n    strategy:\n      matrix:\n        include:\n          - platform: gpu\n          - platform: ubuntu-latest\n    runs-on: ${{ matrix.platform }}\n",
+			true,
+		},
+		{
+			"GitHub-hosted matrix",
+			"jobs:\n  build:\n    strategy:\n      matrix:\n        os:\n          - ubuntu-latest\n          - windows-latest\n    runs-on: ${{ matrix.os }}\n",
+			false,
+		},
+		{"other expression", "jobs:\n  build:\n    runs-on: ${{ inputs.runner }}\n", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := runners.targetedBy(tc.workflow); result != tc.expected {
+				t.Fatalf("expected %t, got %t for:\n%s", tc.expected, result, tc.workflow)
+			}
+		})
+	}
+}
+
+func TestTargetedByWithoutRunners(t *testing.T) {
+	runners := &selfHostedRunners{}
+	if !runners.targetedBy("jobs:\n  build:\n    runs-on: [self-hosted, linux]\n") {
+		t.Fatal("the self-hosted label was not detected")
+	}
+	if runners.targetedBy("jobs:\n  build:\n    runs-on: [linux, gpu]\n") {
+		t.Fatal("a label was detected without any self-hosted runners")
+	}
+}
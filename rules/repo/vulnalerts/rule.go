@@ -32,7 +32,7 @@ func (r rule) ID() string {
 	return "repo-vulnerability-alerts"
 }
 
-func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
 	vulnerabilityAlertsEnabled, err := repo.VulnerabilityAlertsEnabled()
 	if err != nil {
 		return nil, err
@@ -46,7 +46,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: r.Description(),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/settings/security_analysis",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 			),
 			DocURL: r.DocURL(),
@@ -60,7 +60,7 @@ func (r rule) Run(org *github.Organization, repo *github.Repository) ([]hubcheck
 			Description: r.Description(),
 			FixURL: fmt.Sprintf(
 				"https://github.com/%s/%s/settings/security_analysis",
-				url.QueryEscape(org.Login),
+				url.QueryEscape(owner.GetLogin()),
 				url.QueryEscape(repo.Name),
 			),
 			DocURL: r.DocURL(),
@@ -0,0 +1,74 @@
+package workflowapprovals
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/workflowapprovalcheck"
+)
+
+func New() hubcheck.RepoRule {
+	return &rule{}
+}
+
+type rule struct {
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/enabling-features-for-your-repository/managing-github-actions-settings-for-a-repository#controlling-changes-from-forks-to-workflows-in-public-repositories"
+}
+
+func (r rule) Name() string {
+	return "Require workflow approvals on public repositories"
+}
+
+func (r rule) Description() string {
+	return "Anyone can open a pull request from a fork of a public repository. Workflows should not run on these pull requests without the approval of a maintainer, otherwise untrusted code can use your GitHub Actions resources and credentials."
+}
+
+func (r rule) ID() string {
+	return "github-actions-repo-workflow-approvals"
+}
+
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+	// The approval policy only applies to pull requests from forks of public repositories.
+	if repo.Visibility != "public" {
+		return nil, nil
+	}
+	fixURL := fmt.Sprintf(
+		"https://github.com/%s/%s/settings/actions",
+		url.QueryEscape(owner.GetLogin()),
+		url.QueryEscape(repo.Name),
+	)
+	approval, err := repo.GetForkPRContributorApproval()
+	if err != nil {
+		if !github.IsNotFound(err) {
+			return nil, err
+		}
+		return []hubcheck.RuleResult{
+			{
+				Level:       hublog.Info,
+				Repository:  repo.Name,
+				Title:       "Workflow approval requirements (manual check)",
+				Description: "Workflow approvals cannot be checked automatically on this GitHub version, please check them manually. " + r.Description(),
+				FixURL:      fixURL,
+				DocURL:      r.DocURL(),
+			},
+		}, nil
+	}
+
+	level, title := workflowapprovalcheck.Evaluate(approval)
+	return []hubcheck.RuleResult{
+		{
+			Level:       level,
+			Repository:  repo.Name,
+			Title:       title,
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		},
+	}, nil
+}
@@ -0,0 +1,144 @@
+package workflowpermissions
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.debugged.it/hubcheck"
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+func New() hubcheck.RepoRule {
+	return &rule{
+		orgDefaults: map[string]*orgDefaults{},
+	}
+}
+
+type rule struct {
+	// orgDefaults holds the organization defaults per owner, so they are only fetched and reported once.
+	orgDefaults map[string]*orgDefaults
+}
+
+type orgDefaults struct {
+	permissions *github.WorkflowPermissions
+	err         error
+}
+
+func (r rule) DocURL() string {
+	return "https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/enabling-features-for-your-repository/managing-github-actions-settings-for-a-repository#setting-the-permissions-of-the-github_token-for-your-repository"
+}
+
+func (r rule) Name() string {
+	return "Default workflow permissions on repositories"
+}
+
+func (r rule) Description() string {
+	return "The GITHUB_TOKEN should only have read permissions by default, and GitHub Actions should not be able to approve pull requests. Repositories should not loosen the defaults of the organization."
+}
+
+func (r rule) ID() string {
+	return "github-actions-repo-workflow-permissions"
+}
+
+func (r rule) Run(owner github.Owner, repo *github.Repository) ([]hubcheck.RuleResult, error) {
+	permissions, err := repo.GetWorkflowPermissions()
+	if err != nil {
+		return nil, err
+	}
+	fixURL := fmt.Sprintf(
+		"https://github.com/%s/%s/settings/actions",
+		url.QueryEscape(owner.GetLogin()),
+		url.QueryEscape(repo.Name),
+	)
+
+	var orgResults []hubcheck.RuleResult
+	var orgPermissions *github.WorkflowPermissions
+	if org, ok := owner.(*github.Organization); ok {
+		defaults, fetched := r.orgDefaults[org.Login]
+		if !fetched {
+			defaults = &orgDefaults{}
+			defaults.permissions, defaults.err = org.GetWorkflowPermissions()
+			r.orgDefaults[org.Login] = defaults
+			if defaults.err != nil {
+				orgResults = append(orgResults, hubcheck.RuleResult{
+					Level: hublog.Warning,
+					Title: "The organization default workflow permissions could not be checked",
+					Description: fmt.Sprintf(
+						"The repositories of %s are checked without comparing them to the organization defaults. (%v)",
+						org.Login,
+						defaults.err,
+					),
+					FixURL: fmt.Sprintf("https://github.com/organizations/%s/settings/actions", url.QueryEscape(org.Login)),
+					DocURL: r.DocURL(),
+				})
+			}
+		}
+		orgPermissions = defaults.permissions
+	}
+
+	if orgPermissions != nil && permissions.LooserThan(*orgPermissions) {
+		return append(orgResults, hubcheck.RuleResult{
+			Level:      hublog.Error,
+			Repository: repo.Name,
+			Title:      "The workflow permissions are looser than the organization defaults",
+			Description: fmt.Sprintf(
+				"%s The GITHUB_TOKEN has %s permissions and GitHub Actions %s approve pull requests, while the organization defaults to %s permissions and %s.",
+				r.Description(),
+				permissions.DefaultWorkflowPermissions,
+				canOrCannot(permissions.CanApprovePullRequestReviews),
+				orgPermissions.DefaultWorkflowPermissions,
+				approvalText(orgPermissions.CanApprovePullRequestReviews),
+			),
+			FixURL: fixURL,
+			DocURL: r.DocURL(),
+		}), nil
+	}
+
+	var results []hubcheck.RuleResult
+	if permissions.DefaultWorkflowPermissions == "write" {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Repository:  repo.Name,
+			Title:       "The GITHUB_TOKEN has write permissions by default",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	if permissions.CanApprovePullRequestReviews {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Error,
+			Repository:  repo.Name,
+			Title:       "GitHub Actions can approve pull requests",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	if len(results) == 0 {
+		results = append(results, hubcheck.RuleResult{
+			Level:       hublog.Notice,
+			Repository:  repo.Name,
+			Title:       "The GITHUB_TOKEN is read-only by default",
+			Description: r.Description(),
+			FixURL:      fixURL,
+			DocURL:      r.DocURL(),
+		})
+	}
+	return append(orgResults, results...), nil
+}
+
+func canOrCannot(can bool) string {
+	if can {
+		return "can"
+	}
+	return "cannot"
+}
+
+func approvalText(can bool) string {
+	if can {
+		return "allows GitHub Actions to approve pull requests"
+	}
+	return "does not allow GitHub Actions to approve pull requests"
+}
@@ -0,0 +1,63 @@
+package workflowpermissions_test
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/github/githubtest"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/repo/workflowpermissions"
+)
+
+func TestOrgDefaultsFetchedOnce(t *testing.T) {
+	var repos []*githubtest.Repository
+	for _, name := range []string{"a", "b", "c"} {
+		repos = append(repos, &githubtest.Repository{
+			Repository:          github.Repository{Name: name},
+			WorkflowPermissions: &github.WorkflowPermissions{DefaultWorkflowPermissions: "read"},
+		})
+	}
+	srv := githubtest.New(githubtest.State{Organizations: []*githubtest.Organization{
+		{Organization: github.Organization{Login: "acme"}, Repositories: repos},
+	}})
+	defer srv.Close()
+	if err := srv.Inject("orgs/acme/actions/permissions/workflow", githubtest.Fault{StatusCode: 403}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(hublog.New(hublog.Error))
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, err := c.GetOrg("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repositories, err := org.ListRepositories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := workflowpermissions.New()
+	levels := map[hublog.Level]int{}
+	for _, repo := range repositories {
+		results, err := r.Run(org, repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, result := range results {
+			levels[result.Level]++
+		}
+	}
+	if levels[hublog.Warning] != 1 || levels[hublog.Notice] != 3 {
+		t.Fatalf("expected one warning and three notices, got %v", levels)
+	}
+	requests := 0
+	for _, request := range srv.Requests() {
+		if request == "GET orgs/acme/actions/permissions/workflow" {
+			requests++
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("the organization defaults were fetched %d times", requests)
+	}
+}
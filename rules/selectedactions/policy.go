@@ -0,0 +1,77 @@
+// Package selectedactions evaluates the allowlist of GitHub Actions used when the allowed actions are set to
+// "selected". It is shared by the organization and repository GitHub Actions permissions rules.
+package selectedactions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+// Policy describes which allowlist entries are acceptable. The zero value is the strictest policy.
+type Policy struct {
+	// AllowVerifiedCreators permits allowing all actions from verified creators on the GitHub Marketplace.
+	AllowVerifiedCreators bool `json:"allow_verified_creators"`
+	// AllowOwnerWildcards permits patterns allowing all actions of an owner, such as "acme/*". Patterns with a wildcard
+	// in the owner, such as "*/*", are never allowed.
+	AllowOwnerWildcards bool `json:"allow_owner_wildcards"`
+	// AllowUnpinned permits patterns that don't pin the action to a full commit SHA.
+	AllowUnpinned bool `json:"allow_unpinned"`
+}
+
+// Violation is an allowlist entry that violates the policy.
+type Violation struct {
+	// Pattern is the offending pattern. It is empty if the violation is about the verified creators setting.
+	Pattern string
+	Reason  string
+}
+
+func (v Violation) String() string {
+	if v.Pattern == "" {
+		return v.Reason
+	}
+	return fmt.Sprintf("`%s`: %s", v.Pattern, v.Reason)
+}
+
+var shaRe = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Evaluate returns all entries of the allowlist violating the policy.
+func (p Policy) Evaluate(selected *github.SelectedActions) []Violation {
+	var result []Violation
+	if selected.VerifiedAllowed && !p.AllowVerifiedCreators {
+		result = append(result, Violation{
+			Reason: "all actions from verified creators on the GitHub Marketplace are allowed",
+		})
+	}
+	for _, pattern := range selected.PatternsAllowed {
+		pattern = strings.TrimSpace(pattern)
+		name, ref, pinned := strings.Cut(pattern, "@")
+		owner, _, _ := strings.Cut(name, "/")
+		switch {
+		case strings.Contains(owner, "*"):
+			result = append(result, Violation{
+				Pattern: pattern,
+				Reason:  "allows actions from any owner",
+			})
+			continue
+		case strings.Contains(name, "*") && !p.AllowOwnerWildcards:
+			result = append(result, Violation{
+				Pattern: pattern,
+				Reason:  fmt.Sprintf("allows all actions of %s", owner),
+			})
+			continue
+		}
+		if p.AllowUnpinned || strings.Contains(name, "*") {
+			continue
+		}
+		if !pinned || !shaRe.MatchString(ref) {
+			result = append(result, Violation{
+				Pattern: pattern,
+				Reason:  "is not pinned to a full commit SHA",
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package selectedactions_test
+
+import (
+	"reflect"
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/rules/selectedactions"
+)
+
+const sha = "8f4b7f84864484a7bf31766abe9204da3cbe65b3"
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   selectedactions.Policy
+		selected github.SelectedActions
+		expected []string
+	}{
+		{
+			name:     "pinned",
+			selected: github.SelectedActions{PatternsAllowed: []string{"actions/checkout@" + sha}},
+		},
+		{
+			name:     "tag",
+			selected: github.SelectedActions{PatternsAllowed: []string{"actions/checkout@v4"}},
+			expected: []string{"actions/checkout@v4"},
+		},
+		{
+			name:     "short SHA",
+			selected: github.SelectedActions{PatternsAllowed: []string{"actions/checkout@8f4b7f8"}},
+			expected: []string{"actions/checkout@8f4b7f8"},
+		},
+		{
+			name:     "no ref",
+			selected: github.SelectedActions{PatternsAllowed: []string{"actions/checkout"}},
+			expected: []string{"actions/checkout"},
+		},
+		{
+			name:     "unpinned allowed",
+			policy:   selectedactions.Policy{AllowUnpinned: true},
+			selected: github.SelectedActions{PatternsAllowed: []string{"actions/checkout@v4"}},
+		},
+		{
+			name:     "owner wildcard",
+			selected: github.SelectedActions{PatternsAllowed: []string{"acme/*"}},
+			expected: []string{"acme/*"},
+		},
+		{
+			name:     "owner wildcard allowed",
+			policy:   selectedactions.Policy{AllowOwnerWildcards: true},
+			selected: github.SelectedActions{PatternsAllowed: []string{"acme/*", " acme/deploy@main "}},
+			expected: []string{"acme/deploy@main"},
+		},
+		{
+			name: "any owner",
+			policy: selectedactions.Policy{
+				AllowOwnerWildcards: true,
+				AllowUnpinned:       true,
+			},
+			selected: github.SelectedActions{PatternsAllowed: []string{"*/*", "*"}},
+			expected: []string{"*/*", "*"},
+		},
+		{
+			name:     "verified creators",
+			selected: github.SelectedActions{VerifiedAllowed: true},
+			expected: []string{""},
+		},
+		{
+			name:     "verified creators allowed",
+			policy:   selectedactions.Policy{AllowVerifiedCreators: true},
+			selected: github.SelectedActions{VerifiedAllowed: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var patterns []string
+			for _, violation := range tc.policy.Evaluate(&tc.selected) {
+				patterns = append(patterns, violation.Pattern)
+			}
+			if !reflect.DeepEqual(patterns, tc.expected) {
+				t.Fatalf("expected violations for %v, got %v", tc.expected, patterns)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Package webhookcheck finds insecure or broken webhooks. It is shared by the organization and repository webhook
+// rules.
+package webhookcheck
+
+import (
+	"net/url"
+	"strings"
+
+	"go.debugged.it/hubcheck/github"
+)
+
+// Problems returns the problems of a webhook. The deliveries are the latest deliveries of the webhook, latest first.
+func Problems(hook *github.Hook, deliveries []*github.HookDelivery) []string {
+	var result []string
+	if hook.Config.InsecureSSL == "1" {
+		result = append(result, "TLS certificate verification is disabled")
+	}
+	if strings.HasPrefix(strings.ToLower(hook.Config.URL), "http://") {
+		result = append(result, "the payload is sent over unencrypted HTTP")
+	}
+	if hook.Config.Secret == "" {
+		result = append(result, "no secret is set, the receiver cannot verify that the payload comes from GitHub")
+	}
+	// A webhook that was deliberately deactivated is not a problem, unless its deliveries kept failing before.
+	if failing(deliveries) {
+		if hook.Active {
+			result = append(result, "all recent deliveries failed")
+		} else {
+			result = append(result, "the webhook is inactive and its recent deliveries failed")
+		}
+	}
+	return result
+}
+
+func failing(deliveries []*github.HookDelivery) bool {
+	if len(deliveries) == 0 {
+		return false
+	}
+	for _, delivery := range deliveries {
+		if !delivery.Failed() {
+			return false
+		}
+	}
+	return true
+}
+
+// DisplayURL returns the webhook URL without credentials and query parameters, which may contain tokens.
+func DisplayURL(hook *github.Hook) string {
+	u, err := url.Parse(hook.Config.URL)
+	if err != nil || u.Host == "" {
+		return hook.Name
+	}
+	return u.Scheme + "://" + u.Host + u.Path
+}
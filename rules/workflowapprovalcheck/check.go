@@ -0,0 +1,24 @@
+// Package workflowapprovalcheck evaluates the fork pull request workflow approval policy. It is shared by the organization
+// and repository workflow approval rules.
+package workflowapprovalcheck
+
+import (
+	"fmt"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+)
+
+// Evaluate returns the result level and title for a fork pull request workflow approval policy.
+func Evaluate(approval *github.ForkPRContributorApproval) (hublog.Level, string) {
+	switch approval.ApprovalPolicy {
+	case github.ApprovalPolicyAllExternalContributors:
+		return hublog.Notice, "Workflows require approval for all outside contributors"
+	case github.ApprovalPolicyFirstTimeContributors:
+		return hublog.Warning, "Outside contributors who contributed before can run workflows without approval"
+	case github.ApprovalPolicyFirstTimeContributorsNewToGitHub:
+		return hublog.Error, "First-time contributors can run workflows without approval"
+	default:
+		return hublog.Warning, fmt.Sprintf("Unknown workflow approval policy: %s", approval.ApprovalPolicy)
+	}
+}
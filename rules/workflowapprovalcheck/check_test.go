@@ -0,0 +1,28 @@
+package workflowapprovalcheck_test
+
+import (
+	"testing"
+
+	"go.debugged.it/hubcheck/github"
+	"go.debugged.it/hubcheck/hublog"
+	"go.debugged.it/hubcheck/rules/workflowapprovalcheck"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := map[string]hublog.Level{
+		github.ApprovalPolicyAllExternalContributors:          hublog.Notice,
+		github.ApprovalPolicyFirstTimeContributors:            hublog.Warning,
+		github.ApprovalPolicyFirstTimeContributorsNewToGitHub: hublog.Error,
+		"":               hublog.Warning,
+		"something_else": hublog.Warning,
+	}
+	for policy, expected := range tests {
+		level, title := workflowapprovalcheck.Evaluate(&github.ForkPRContributorApproval{ApprovalPolicy: policy})
+		if level != expected {
+			t.Errorf("expected %s for %q, got %s (%s)", expected, policy, level, title)
+		}
+		if title == "" {
+			t.Errorf("no title for %q", policy)
+		}
+	}
+}